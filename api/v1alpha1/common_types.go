@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // EntityMetadata contains common fields for all MQTT entity types.
@@ -28,7 +29,7 @@ type EntityMetadata struct {
 
 	// UniqueId is the unique identifier for HA entity registry (defaults to <namespace>-<name>)
 	// +optional
-	UniqueId string `json:"uniqueId,omitempty"`
+	UniqueId string `json:"uniqueId,omitempty" hass:"-"`
 
 	// Icon is the MDI icon (e.g. mdi:thermometer)
 	// +optional
@@ -43,7 +44,8 @@ type EntityMetadata struct {
 	// +optional
 	EnabledByDefault *bool `json:"enabledByDefault,omitempty"`
 
-	// ObjectId is the override for HA entity ID generation
+	// ObjectId is the override for HA entity ID generation. It is published
+	// as default_entity_id when targeting Home Assistant 2025.10 or later.
 	// +optional
 	ObjectId string `json:"objectId,omitempty"`
 }
@@ -124,20 +126,22 @@ type AvailabilityConfig struct {
 }
 
 // CommonSpec contains fields common to all MQTT entity specs.
+// Fields tagged hass:"-" are not copied into the discovery payload as they
+// are; the controller resolves them or does not send them to Home Assistant.
 type CommonSpec struct {
 	EntityMetadata `json:",inline"`
 
 	// Device is the device configuration for Home Assistant device registry
 	// +optional
-	Device *DeviceBlock `json:"device,omitempty"`
+	Device *DeviceBlock `json:"device,omitempty" hass:"-"`
 
 	// DeviceRef is a reference to an MQTTDevice resource instead of inline device block
 	// +optional
-	DeviceRef *DeviceRef `json:"deviceRef,omitempty"`
+	DeviceRef *DeviceRef `json:"deviceRef,omitempty" hass:"-"`
 
 	// Availability is a list of availability topics
 	// +optional
-	Availability []AvailabilityConfig `json:"availability,omitempty"`
+	Availability []AvailabilityConfig `json:"availability,omitempty" hass:"-"`
 
 	// AvailabilityTopic is a simple availability topic (shorthand for single availability)
 	// +optional
@@ -146,7 +150,7 @@ type CommonSpec struct {
 	// AvailabilityMode is how to combine multiple availability topics
 	// +kubebuilder:validation:Enum=all;any;latest
 	// +optional
-	AvailabilityMode string `json:"availabilityMode,omitempty"`
+	AvailabilityMode string `json:"availabilityMode,omitempty" hass:"-"`
 
 	// Qos is the MQTT QoS level
 	// +kubebuilder:validation:Minimum=0
@@ -172,7 +176,15 @@ type CommonSpec struct {
 
 	// RediscoverInterval is how often to re-publish the discovery config payload (e.g. 5m, 1h)
 	// +optional
-	RediscoverInterval string `json:"rediscoverInterval,omitempty"`
+	RediscoverInterval string `json:"rediscoverInterval,omitempty" hass:"-"`
+
+	// ExtraConfig is merged into the discovery payload as it is, for options
+	// the typed fields do not cover yet. Its keys override typed fields, but
+	// not unique_id, device and origin, which the controller manages.
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	ExtraConfig *runtime.RawExtension `json:"extraConfig,omitempty" hass:"-"`
 }
 
 // Condition contains details for the current condition of this resource.
@@ -214,12 +226,35 @@ type CommonStatus struct {
 	// Conditions is the list of conditions for this resource
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+
+	// AppliedDefaults lists the MQTTDefaults and ClusterMQTTDefaults that
+	// select the resource, as MQTTDefaults/<name> or ClusterMQTTDefaults/<name>
+	// +optional
+	AppliedDefaults []string `json:"appliedDefaults,omitempty"`
 }
 
 // ConditionType constants for status conditions.
 const (
 	ConditionTypePublished     = "Published"
 	ConditionTypeMQTTConnected = "MQTTConnected"
+	// ConditionTypeDrifted is True while the retained discovery config on the
+	// broker differs from the one published for the resource.
+	ConditionTypeDrifted = "Drifted"
+	// ConditionTypeInvalidPayload is True while the discovery payload built
+	// for the resource is refused because Home Assistant would reject it.
+	ConditionTypeInvalidPayload = "InvalidPayload"
+	// ConditionTypeIncompatibleClasses is True while the device class, unit
+	// and state class of the resource do not go together in Home Assistant.
+	ConditionTypeIncompatibleClasses = "IncompatibleClasses"
+	// ConditionTypeExtraConfigOverrides is True while extraConfig sets keys
+	// of typed fields or keys the controller manages.
+	ConditionTypeExtraConfigOverrides = "ExtraConfigOverrides"
+	// ConditionTypeDeprecatedKeys is True while the spec sets keys that are
+	// deprecated or removed in the targeted Home Assistant release.
+	ConditionTypeDeprecatedKeys = "DeprecatedKeys"
+	// ConditionTypeIgnoredFields is True while the spec sets fields that are
+	// left out of the discovery payload because another field takes precedence.
+	ConditionTypeIgnoredFields = "IgnoredFields"
 )
 
 // ConditionStatus constants.
@@ -0,0 +1,259 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/spontus/hass-crds/api/v1beta1"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestConvertLight(t *testing.T) {
+	transition := metav1.NewTime(time.Unix(1700000000, 0))
+	light := &MQTTLight{
+		TypeMeta:   metav1.TypeMeta{APIVersion: GroupVersion.String(), Kind: "MQTTLight"},
+		ObjectMeta: metav1.ObjectMeta{Name: "desk", Namespace: "default", Generation: 3},
+		Spec: MQTTLightSpec{
+			CommonSpec: CommonSpec{
+				Device: &DeviceBlock{
+					Name:        "Desk",
+					Connections: [][]string{{"mac", "aa:bb:cc:dd:ee:ff"}},
+				},
+			},
+			CommandTopic:        "desk/set",
+			Schema:              "json",
+			BrightnessScale:     ptr(100),
+			Brightness:          ptr(true),
+			SupportedColorModes: []string{"rgb"},
+			PayloadOn:           "on",
+			RedTemplate:         "{{ value_json.r }}",
+		},
+		Status: MQTTLightStatus{CommonStatus: CommonStatus{
+			ObservedGeneration: 3,
+			Conditions: []Condition{{
+				Type:               ConditionTypePublished,
+				Status:             ConditionTrue,
+				LastTransitionTime: &transition,
+				Reason:             "Published",
+			}},
+		}},
+	}
+
+	hub := &v1beta1.MQTTLight{}
+	if err := light.DeepCopy().ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	if hub.APIVersion != v1beta1.GroupVersion.String() {
+		t.Errorf("apiVersion = %q", hub.APIVersion)
+	}
+	if want := []v1beta1.DeviceConnection{{Type: "mac", Value: "aa:bb:cc:dd:ee:ff"}}; !reflect.DeepEqual(hub.Spec.Device.Connections, want) {
+		t.Errorf("connections = %v, want %v", hub.Spec.Device.Connections, want)
+	}
+	if hub.Spec.BrightnessScale == nil || *hub.Spec.BrightnessScale != 100 {
+		t.Errorf("brightnessScale = %v, want 100", hub.Spec.BrightnessScale)
+	}
+	if hub.Spec.JSON == nil || hub.Spec.JSON.Brightness == nil || !*hub.Spec.JSON.Brightness {
+		t.Errorf("json = %+v, want brightness set", hub.Spec.JSON)
+	}
+	if hub.Spec.Default == nil || hub.Spec.Default.PayloadOn != "on" {
+		t.Errorf("default = %+v, want payloadOn set", hub.Spec.Default)
+	}
+	if hub.Spec.Template == nil || hub.Spec.Template.RedTemplate != "{{ value_json.r }}" {
+		t.Errorf("template = %+v, want redTemplate set", hub.Spec.Template)
+	}
+	if len(hub.Status.Conditions) != 1 || hub.Status.Conditions[0].ObservedGeneration != 3 ||
+		hub.Status.Conditions[0].Status != metav1.ConditionTrue {
+		t.Errorf("conditions = %+v", hub.Status.Conditions)
+	}
+
+	back := &MQTTLight{}
+	if err := back.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+	if !reflect.DeepEqual(back, light) {
+		t.Errorf("round trip changed the object\n got: %+v\nwant: %+v", back, light)
+	}
+}
+
+// Lights created before v1alpha1 only accepted the fields of the selected
+// schema may set fields of several schemas; they have to survive a round
+// trip through the hub in both directions, whatever the schema.
+func TestConvertLightMixedSchemas(t *testing.T) {
+	for _, schema := range []string{"", "default", "json", "template"} {
+		t.Run("schema "+schema, func(t *testing.T) {
+			light := &MQTTLight{
+				TypeMeta:   metav1.TypeMeta{APIVersion: GroupVersion.String(), Kind: "MQTTLight"},
+				ObjectMeta: metav1.ObjectMeta{Name: "desk", Namespace: "default"},
+				Spec: MQTTLightSpec{
+					CommandTopic:       "desk/set",
+					Schema:             schema,
+					PayloadOn:          "on",
+					OnCommandType:      "brightness",
+					Brightness:         ptr(true),
+					Effect:             ptr(false),
+					CommandOnTemplate:  "on,{{ brightness }}",
+					CommandOffTemplate: "off",
+				},
+			}
+
+			hub := &v1beta1.MQTTLight{}
+			if err := light.DeepCopy().ConvertTo(hub); err != nil {
+				t.Fatalf("ConvertTo: %v", err)
+			}
+			if hub.Spec.Schema != schema || hub.Spec.Default == nil || hub.Spec.JSON == nil || hub.Spec.Template == nil {
+				t.Errorf("spec = %+v, want schema %q and every sub-struct set", hub.Spec, schema)
+			}
+			back := &MQTTLight{}
+			if err := back.ConvertFrom(hub.DeepCopy()); err != nil {
+				t.Fatalf("ConvertFrom: %v", err)
+			}
+			if !reflect.DeepEqual(back, light) {
+				t.Errorf("round trip from v1alpha1 changed the object\n got: %+v\nwant: %+v", back.Spec, light.Spec)
+			}
+
+			again := &v1beta1.MQTTLight{}
+			if err := back.ConvertTo(again); err != nil {
+				t.Fatalf("ConvertTo: %v", err)
+			}
+			if !reflect.DeepEqual(again, hub) {
+				t.Errorf("round trip from v1beta1 changed the object\n got: %+v\nwant: %+v", again.Spec, hub.Spec)
+			}
+		})
+	}
+}
+
+// v1alpha1 conditions may leave out the fields metav1.Condition requires;
+// they are defaulted so the hub object passes validation.
+func TestConvertConditionDefaults(t *testing.T) {
+	created := metav1.NewTime(time.Unix(1700000000, 0))
+	sensor := &MQTTSensor{
+		TypeMeta:   metav1.TypeMeta{APIVersion: GroupVersion.String(), Kind: "MQTTSensor"},
+		ObjectMeta: metav1.ObjectMeta{Name: "flow", Namespace: "default", CreationTimestamp: created},
+		Spec:       MQTTSensorSpec{StateTopic: "boiler/flow"},
+		Status: MQTTSensorStatus{CommonStatus: CommonStatus{
+			Conditions: []Condition{{Type: ConditionTypePublished, Status: ConditionUnknown}},
+		}},
+	}
+
+	hub := &v1beta1.MQTTSensor{}
+	if err := sensor.DeepCopy().ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	want := []metav1.Condition{{
+		Type:               ConditionTypePublished,
+		Status:             metav1.ConditionUnknown,
+		LastTransitionTime: created,
+		Reason:             "Unknown",
+	}}
+	if !reflect.DeepEqual(hub.Status.Conditions, want) {
+		t.Errorf("conditions = %+v, want %+v", hub.Status.Conditions, want)
+	}
+
+	back := &MQTTSensor{}
+	if err := back.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+	wantBack := []Condition{{Type: ConditionTypePublished, Status: ConditionUnknown, LastTransitionTime: &created, Reason: "Unknown"}}
+	if !reflect.DeepEqual(back.Status.Conditions, wantBack) {
+		t.Errorf("round trip conditions = %+v, want %+v", back.Status.Conditions, wantBack)
+	}
+
+	again := &v1beta1.MQTTSensor{}
+	if err := back.ConvertTo(again); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	if !reflect.DeepEqual(again, hub) {
+		t.Errorf("second round trip changed the object\n got: %+v\nwant: %+v", again.Status, hub.Status)
+	}
+}
+
+func TestConvertDevice(t *testing.T) {
+	hub := &v1beta1.MQTTDevice{
+		Spec: v1beta1.MQTTDeviceSpec{
+			Name:        "Hub",
+			Connections: []v1beta1.DeviceConnection{{Type: "zigbee", Value: "0x00158d"}},
+		},
+	}
+	device := &MQTTDevice{}
+	if err := device.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+	if want := [][]string{{"zigbee", "0x00158d"}}; !reflect.DeepEqual(device.Spec.Connections, want) {
+		t.Errorf("connections = %v, want %v", device.Spec.Connections, want)
+	}
+
+	device.Spec.Connections = append(device.Spec.Connections, []string{"mac"})
+	if err := device.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	want := []v1beta1.DeviceConnection{{Type: "zigbee", Value: "0x00158d"}, {Type: "mac"}}
+	if !reflect.DeepEqual(hub.Spec.Connections, want) {
+		t.Errorf("connections = %v, want %v", hub.Spec.Connections, want)
+	}
+}
+
+func TestLightSchemaFields(t *testing.T) {
+	for schema, typ := range map[string]reflect.Type{
+		"default":  reflect.TypeOf(v1beta1.LightDefaultSchema{}),
+		"json":     reflect.TypeOf(v1beta1.LightJSONSchema{}),
+		"template": reflect.TypeOf(v1beta1.LightTemplateSchema{}),
+	} {
+		var fields []string
+		for i := 0; i < typ.NumField(); i++ {
+			fields = append(fields, strings.Split(typ.Field(i).Tag.Get("json"), ",")[0])
+		}
+		got := append([]string(nil), lightSchemaFields[schema]...)
+		sort.Strings(got)
+		sort.Strings(fields)
+		if !reflect.DeepEqual(got, fields) {
+			t.Errorf("%s: lightSchemaFields = %v, want the fields of %s %v", schema, got, typ.Name(), fields)
+		}
+	}
+}
+
+func TestIsConvertible(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for kind := range scheme.KnownTypes(v1beta1.GroupVersion) {
+		if !strings.HasPrefix(kind, "MQTT") || strings.HasSuffix(kind, "List") {
+			continue
+		}
+		obj, err := scheme.New(GroupVersion.WithKind(kind))
+		if err != nil {
+			t.Errorf("%s has no v1alpha1 type: %v", kind, err)
+			continue
+		}
+		if ok, err := conversion.IsConvertible(scheme, obj); !ok || err != nil {
+			t.Errorf("%s is not convertible: %v", kind, err)
+		}
+	}
+}
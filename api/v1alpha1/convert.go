@@ -0,0 +1,236 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/spontus/hass-crds/api/v1beta1"
+)
+
+// defaultConditionReason is the reason of a converted condition that has none.
+const defaultConditionReason = "Unknown"
+
+// lightSchemaFields lists the MQTTLight fields that v1beta1 moves into the
+// sub-struct of the schema that understands them. Both versions only accept
+// the fields of the selected schema, but lights created before v1alpha1 did
+// may set others. Each field always goes to the same sub-struct whatever
+// schema is set, so conversion is lossless for them as well.
+var lightSchemaFields = map[string][]string{
+	"default": {
+		"payloadOn", "payloadOff",
+		"brightnessCommandTopic", "brightnessStateTopic", "brightnessValueTemplate",
+		"colorTempCommandTopic", "colorTempStateTopic", "colorTempValueTemplate",
+		"rgbCommandTopic", "rgbStateTopic", "rgbCommandTemplate", "rgbValueTemplate",
+		"effectCommandTopic", "effectStateTopic", "effectValueTemplate",
+		"onCommandType",
+	},
+	"json": {
+		"brightness", "colorTemp", "effect", "supportedColorModes",
+	},
+	"template": {
+		"commandOnTemplate", "commandOffTemplate", "stateTemplate",
+		"brightnessTemplate", "colorTempTemplate",
+		"redTemplate", "greenTemplate", "blueTemplate",
+	},
+}
+
+// convertTo converts src to the hub version dst. Both are handled as JSON
+// objects, and only the fields whose shape differs are moved around.
+func convertTo(src runtime.Object, dst conversion.Hub) error {
+	obj, err := toMap(src)
+	if err != nil {
+		return err
+	}
+	spec, _ := obj["spec"].(map[string]interface{})
+	if device, ok := spec["device"].(map[string]interface{}); ok {
+		pairsToConnections(device)
+	}
+	switch src.(type) {
+	case *MQTTDevice:
+		pairsToConnections(spec)
+	case *MQTTLight:
+		nestLightFields(spec)
+	}
+	if status, ok := obj["status"].(map[string]interface{}); ok {
+		metadata, _ := obj["metadata"].(map[string]interface{})
+		conditionsToHub(status, metadata["creationTimestamp"])
+	}
+	obj["apiVersion"] = v1beta1.GroupVersion.String()
+	return fromMap(obj, dst)
+}
+
+// convertFrom converts the hub version src to dst.
+func convertFrom(src conversion.Hub, dst runtime.Object) error {
+	obj, err := toMap(src)
+	if err != nil {
+		return err
+	}
+	spec, _ := obj["spec"].(map[string]interface{})
+	if device, ok := spec["device"].(map[string]interface{}); ok {
+		connectionsToPairs(device)
+	}
+	switch dst.(type) {
+	case *MQTTDevice:
+		connectionsToPairs(spec)
+	case *MQTTLight:
+		flattenLightFields(spec)
+	}
+	if status, ok := obj["status"].(map[string]interface{}); ok {
+		conditionsFromHub(status)
+	}
+	obj["apiVersion"] = GroupVersion.String()
+	return fromMap(obj, dst)
+}
+
+// pairsToConnections turns the [type, value] pairs in parent's connections
+// into {type, value} objects. Missing values are left empty.
+func pairsToConnections(parent map[string]interface{}) {
+	pairs, ok := parent["connections"].([]interface{})
+	if !ok {
+		return
+	}
+	connections := make([]interface{}, 0, len(pairs))
+	for _, p := range pairs {
+		pair, _ := p.([]interface{})
+		connection := map[string]interface{}{"type": "", "value": ""}
+		if len(pair) > 0 {
+			connection["type"] = pair[0]
+		}
+		if len(pair) > 1 {
+			connection["value"] = pair[1]
+		}
+		connections = append(connections, connection)
+	}
+	parent["connections"] = connections
+}
+
+// connectionsToPairs turns the {type, value} objects in parent's
+// connections into [type, value] pairs.
+func connectionsToPairs(parent map[string]interface{}) {
+	connections, ok := parent["connections"].([]interface{})
+	if !ok {
+		return
+	}
+	pairs := make([]interface{}, 0, len(connections))
+	for _, c := range connections {
+		connection, _ := c.(map[string]interface{})
+		pairs = append(pairs, []interface{}{connection["type"], connection["value"]})
+	}
+	parent["connections"] = pairs
+}
+
+// nestLightFields moves the schema-specific fields of an MQTTLight spec
+// into their sub-structs.
+func nestLightFields(spec map[string]interface{}) {
+	for schema, fields := range lightSchemaFields {
+		nested := map[string]interface{}{}
+		for _, field := range fields {
+			if v, ok := spec[field]; ok {
+				nested[field] = v
+				delete(spec, field)
+			}
+		}
+		if len(nested) > 0 {
+			spec[schema] = nested
+		}
+	}
+}
+
+// flattenLightFields moves the fields of the schema sub-structs of an
+// MQTTLight spec back into the spec.
+func flattenLightFields(spec map[string]interface{}) {
+	for schema := range lightSchemaFields {
+		nested, ok := spec[schema].(map[string]interface{})
+		delete(spec, schema)
+		if !ok {
+			continue
+		}
+		for field, v := range nested {
+			spec[field] = v
+		}
+	}
+}
+
+// conditionsToHub gives every condition the observedGeneration of the
+// status, which metav1.Condition records per condition. The fields
+// metav1.Condition requires and v1alpha1 conditions may leave out are
+// defaulted: reason to defaultConditionReason, message to empty and
+// lastTransitionTime to created, the creation time of the object.
+func conditionsToHub(status map[string]interface{}, created interface{}) {
+	conditions, _ := status["conditions"].([]interface{})
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if generation, ok := status["observedGeneration"]; ok {
+			condition["observedGeneration"] = generation
+		}
+		if reason, _ := condition["reason"].(string); reason == "" {
+			condition["reason"] = defaultConditionReason
+		}
+		if _, ok := condition["message"]; !ok {
+			condition["message"] = ""
+		}
+		if condition["lastTransitionTime"] == nil && created != nil {
+			condition["lastTransitionTime"] = created
+		}
+	}
+}
+
+// conditionsFromHub drops the fields v1alpha1 conditions do not have.
+func conditionsFromHub(status map[string]interface{}) {
+	conditions, _ := status["conditions"].([]interface{})
+	for _, c := range conditions {
+		if condition, ok := c.(map[string]interface{}); ok {
+			delete(condition, "observedGeneration")
+		}
+	}
+}
+
+// toMap encodes obj as a JSON object, keeping numbers as they are.
+func toMap(obj runtime.Object) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %T: %w", obj, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var m map[string]interface{}
+	if err := decoder.Decode(&m); err != nil {
+		return nil, fmt.Errorf("decoding %T: %w", obj, err)
+	}
+	return m, nil
+}
+
+// fromMap decodes the JSON object m into obj.
+func fromMap(m map[string]interface{}, obj runtime.Object) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("encoding %T: %w", obj, err)
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("decoding %T: %w", obj, err)
+	}
+	return nil
+}
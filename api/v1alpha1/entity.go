@@ -0,0 +1,310 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// The methods below give every entity kind access to its CommonSpec and
+// CommonStatus, so controllers can handle all kinds through one interface.
+
+// GetCommonSpec returns the fields of MQTTAlarmControlPanel shared by all entity kinds.
+func (in *MQTTAlarmControlPanel) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTAlarmControlPanel shared by all entity kinds.
+func (in *MQTTAlarmControlPanel) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTBinarySensor shared by all entity kinds.
+func (in *MQTTBinarySensor) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTBinarySensor shared by all entity kinds.
+func (in *MQTTBinarySensor) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTButton shared by all entity kinds.
+func (in *MQTTButton) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTButton shared by all entity kinds.
+func (in *MQTTButton) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTCamera shared by all entity kinds.
+func (in *MQTTCamera) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTCamera shared by all entity kinds.
+func (in *MQTTCamera) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTClimate shared by all entity kinds.
+func (in *MQTTClimate) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTClimate shared by all entity kinds.
+func (in *MQTTClimate) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTCover shared by all entity kinds.
+func (in *MQTTCover) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTCover shared by all entity kinds.
+func (in *MQTTCover) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTDeviceTracker shared by all entity kinds.
+func (in *MQTTDeviceTracker) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTDeviceTracker shared by all entity kinds.
+func (in *MQTTDeviceTracker) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTDeviceTrigger shared by all entity kinds.
+func (in *MQTTDeviceTrigger) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTDeviceTrigger shared by all entity kinds.
+func (in *MQTTDeviceTrigger) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTEntity shared by all entity kinds.
+func (in *MQTTEntity) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTEntity shared by all entity kinds.
+func (in *MQTTEntity) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTEvent shared by all entity kinds.
+func (in *MQTTEvent) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTEvent shared by all entity kinds.
+func (in *MQTTEvent) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTFan shared by all entity kinds.
+func (in *MQTTFan) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTFan shared by all entity kinds.
+func (in *MQTTFan) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTHumidifier shared by all entity kinds.
+func (in *MQTTHumidifier) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTHumidifier shared by all entity kinds.
+func (in *MQTTHumidifier) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTImage shared by all entity kinds.
+func (in *MQTTImage) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTImage shared by all entity kinds.
+func (in *MQTTImage) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTLawnMower shared by all entity kinds.
+func (in *MQTTLawnMower) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTLawnMower shared by all entity kinds.
+func (in *MQTTLawnMower) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTLight shared by all entity kinds.
+func (in *MQTTLight) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTLight shared by all entity kinds.
+func (in *MQTTLight) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTLock shared by all entity kinds.
+func (in *MQTTLock) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTLock shared by all entity kinds.
+func (in *MQTTLock) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTNotify shared by all entity kinds.
+func (in *MQTTNotify) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTNotify shared by all entity kinds.
+func (in *MQTTNotify) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTNumber shared by all entity kinds.
+func (in *MQTTNumber) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTNumber shared by all entity kinds.
+func (in *MQTTNumber) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTScene shared by all entity kinds.
+func (in *MQTTScene) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTScene shared by all entity kinds.
+func (in *MQTTScene) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTSelect shared by all entity kinds.
+func (in *MQTTSelect) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTSelect shared by all entity kinds.
+func (in *MQTTSelect) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTSensor shared by all entity kinds.
+func (in *MQTTSensor) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTSensor shared by all entity kinds.
+func (in *MQTTSensor) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTSiren shared by all entity kinds.
+func (in *MQTTSiren) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTSiren shared by all entity kinds.
+func (in *MQTTSiren) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTSwitch shared by all entity kinds.
+func (in *MQTTSwitch) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTSwitch shared by all entity kinds.
+func (in *MQTTSwitch) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTTag shared by all entity kinds.
+func (in *MQTTTag) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTTag shared by all entity kinds.
+func (in *MQTTTag) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTText shared by all entity kinds.
+func (in *MQTTText) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTText shared by all entity kinds.
+func (in *MQTTText) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTUpdate shared by all entity kinds.
+func (in *MQTTUpdate) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTUpdate shared by all entity kinds.
+func (in *MQTTUpdate) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTVacuum shared by all entity kinds.
+func (in *MQTTVacuum) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTVacuum shared by all entity kinds.
+func (in *MQTTVacuum) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTValve shared by all entity kinds.
+func (in *MQTTValve) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTValve shared by all entity kinds.
+func (in *MQTTValve) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
+
+// GetCommonSpec returns the fields of MQTTWaterHeater shared by all entity kinds.
+func (in *MQTTWaterHeater) GetCommonSpec() *CommonSpec {
+	return &in.Spec.CommonSpec
+}
+
+// GetCommonStatus returns the status of MQTTWaterHeater shared by all entity kinds.
+func (in *MQTTWaterHeater) GetCommonStatus() *CommonStatus {
+	return &in.Status.CommonStatus
+}
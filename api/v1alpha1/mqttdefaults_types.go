@@ -0,0 +1,151 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EntityDefaults are the fields of CommonSpec that MQTTDefaults and
+// ClusterMQTTDefaults set on the entities they select. A field is only set
+// on entities that leave it unset; device and deviceRef count as one field,
+// as do availability and availabilityTopic.
+type EntityDefaults struct {
+	// EntityCategory is the entity category
+	// +kubebuilder:validation:Enum=config;diagnostic
+	// +optional
+	EntityCategory string `json:"entityCategory,omitempty"`
+
+	// EnabledByDefault indicates whether the entity is enabled when first discovered
+	// +optional
+	EnabledByDefault *bool `json:"enabledByDefault,omitempty"`
+
+	// Device is the device configuration for Home Assistant device registry
+	// +optional
+	Device *DeviceBlock `json:"device,omitempty"`
+
+	// DeviceRef is a reference to an MQTTDevice resource in the namespace of the entity
+	// +optional
+	DeviceRef *DeviceRef `json:"deviceRef,omitempty"`
+
+	// Availability is a list of availability topics
+	// +optional
+	Availability []AvailabilityConfig `json:"availability,omitempty"`
+
+	// AvailabilityTopic is a simple availability topic (shorthand for single availability)
+	// +optional
+	AvailabilityTopic string `json:"availabilityTopic,omitempty"`
+
+	// AvailabilityMode is how to combine multiple availability topics
+	// +kubebuilder:validation:Enum=all;any;latest
+	// +optional
+	AvailabilityMode string `json:"availabilityMode,omitempty"`
+
+	// Qos is the MQTT QoS level
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	Qos *int `json:"qos,omitempty"`
+
+	// Retain indicates whether to retain messages on command/state topics
+	// +optional
+	Retain *bool `json:"retain,omitempty"`
+
+	// Encoding is the payload encoding (default: utf-8)
+	// +optional
+	Encoding string `json:"encoding,omitempty"`
+
+	// RediscoverInterval is how often to re-publish the discovery config payload (e.g. 5m, 1h)
+	// +optional
+	RediscoverInterval string `json:"rediscoverInterval,omitempty"`
+}
+
+// MQTTDefaultsSpec defines the desired state of MQTTDefaults.
+type MQTTDefaultsSpec struct {
+	EntityDefaults `json:",inline"`
+
+	// Selector selects the entities the defaults apply to by their labels.
+	// All entities are selected when it is unset.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Kinds limits the defaults to entities of these kinds (e.g. MQTTSensor).
+	// Entities of every kind are selected when it is empty.
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+
+	// SuggestedAreaLabel is a label of the namespace of the entity whose value
+	// is used as device.suggestedArea when the device of the entity has none
+	// +optional
+	SuggestedAreaLabel string `json:"suggestedAreaLabel,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MQTTDefaults is the Schema for the mqttdefaults API.
+// It sets shared fields on the entities of its namespace. Entities always
+// take precedence; MQTTDefaults take precedence over ClusterMQTTDefaults.
+type MQTTDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MQTTDefaultsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MQTTDefaultsList contains a list of MQTTDefaults.
+type MQTTDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MQTTDefaults `json:"items"`
+}
+
+// ClusterMQTTDefaultsSpec defines the desired state of ClusterMQTTDefaults.
+type ClusterMQTTDefaultsSpec struct {
+	MQTTDefaultsSpec `json:",inline"`
+
+	// NamespaceSelector selects the namespaces whose entities the defaults
+	// apply to by their labels. All namespaces are selected when it is unset.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ClusterMQTTDefaults is the Schema for the clustermqttdefaults API.
+// It sets shared fields on the entities of every selected namespace.
+type ClusterMQTTDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterMQTTDefaultsSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterMQTTDefaultsList contains a list of ClusterMQTTDefaults.
+type ClusterMQTTDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterMQTTDefaults `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MQTTDefaults{}, &MQTTDefaultsList{}, &ClusterMQTTDefaults{}, &ClusterMQTTDefaultsList{})
+}
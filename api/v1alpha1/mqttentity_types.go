@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MQTTEntitySpec defines the desired state of MQTTEntity.
+type MQTTEntitySpec struct {
+	CommonSpec `json:",inline"`
+
+	// Component is the Home Assistant MQTT platform the entity is published
+	// as, e.g. valve or a platform added after this release
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_]+$`
+	Component string `json:"component" hass:"-"`
+
+	// Config holds the platform-specific discovery options. It is merged into
+	// the discovery payload as it is, before extraConfig.
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty" hass:"-"`
+}
+
+// MQTTEntityStatus defines the observed state of MQTTEntity.
+type MQTTEntityStatus struct {
+	CommonStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// MQTTEntity is the Schema for the mqttentities API.
+// It publishes an entity of any Home Assistant MQTT platform, for platforms
+// and options that have no typed kind.
+type MQTTEntity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MQTTEntitySpec   `json:"spec,omitempty"`
+	Status MQTTEntityStatus `json:"status,omitempty"`
+}
+
+// GetComponent returns the Home Assistant component MQTTEntity is published as.
+func (in *MQTTEntity) GetComponent() string {
+	return in.Spec.Component
+}
+
+// GetConfig returns the platform-specific discovery options of MQTTEntity.
+func (in *MQTTEntity) GetConfig() *runtime.RawExtension {
+	return in.Spec.Config
+}
+
+// +kubebuilder:object:root=true
+
+// MQTTEntityList contains a list of MQTTEntity.
+type MQTTEntityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MQTTEntity `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MQTTEntity{}, &MQTTEntityList{})
+}
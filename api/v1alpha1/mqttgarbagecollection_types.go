@@ -0,0 +1,160 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GarbageCollectionTriggerAnnotation requests an immediate garbage
+	// collection cycle when its value changes.
+	GarbageCollectionTriggerAnnotation = "mqtt.home-assistant.io/gc-trigger"
+
+	// GarbageCollectionProtectAnnotation set to "true" as an annotation or
+	// label on an MQTTDevice exempts every entity of that device from
+	// garbage collection.
+	GarbageCollectionProtectAnnotation = "mqtt.home-assistant.io/gc-protect"
+
+	// Garbage collection triggers recorded in GarbageCollectionRun.Trigger.
+	GarbageCollectionTriggerStartup   = "Startup"
+	GarbageCollectionTriggerScheduled = "Scheduled"
+	GarbageCollectionTriggerManual    = "Manual"
+
+	// Garbage collection results recorded in GarbageCollectionRun.Result.
+	GarbageCollectionSucceeded = "Succeeded"
+	GarbageCollectionFailed    = "Failed"
+	GarbageCollectionRefused   = "Refused"
+
+	// Actions taken on an orphan, recorded in OrphanReport.Action.
+	OrphanActionRemoved      = "Removed"
+	OrphanActionWouldRemove  = "WouldRemove"
+	OrphanActionRemoveFailed = "RemoveFailed"
+	OrphanActionPending      = "Pending"
+	OrphanActionProtected    = "Protected"
+	OrphanActionRefused      = "Refused"
+)
+
+// MQTTGarbageCollectionSpec defines the desired state of MQTTGarbageCollection.
+type MQTTGarbageCollectionSpec struct {
+	// DryRun reports orphans without removing them. Overrides GC_DRY_RUN when set.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// OrphanReport describes an orphaned discovery topic found by a garbage collection cycle.
+type OrphanReport struct {
+	// Topic is the discovery topic
+	Topic string `json:"topic"`
+
+	// Component is the Home Assistant component from the topic
+	// +optional
+	Component string `json:"component,omitempty"`
+
+	// OriginName is origin.name from the discovery payload
+	// +optional
+	OriginName string `json:"originName,omitempty"`
+
+	// OriginSwVersion is origin.sw_version from the discovery payload
+	// +optional
+	OriginSwVersion string `json:"originSwVersion,omitempty"`
+
+	// ObservedCycles is how many consecutive cycles have found this orphan
+	// +optional
+	ObservedCycles int `json:"observedCycles,omitempty"`
+
+	// Action is what the collector did: Removed, WouldRemove (dry run),
+	// RemoveFailed, Pending (within the grace period), Protected (device has
+	// the gc-protect annotation) or Refused (deletion cap exceeded)
+	Action string `json:"action"`
+
+	// Error is the publish error when Action is RemoveFailed
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// GarbageCollectionRun summarizes a garbage collection cycle.
+type GarbageCollectionRun struct {
+	// StartTime is when the cycle started
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is when the cycle finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Trigger is what started the cycle: Startup, Scheduled or Manual
+	Trigger string `json:"trigger"`
+
+	// DryRun is true if orphans were only reported
+	DryRun bool `json:"dryRun"`
+
+	// Result is Succeeded, Failed, or Refused when the deletion cap was exceeded
+	Result string `json:"result"`
+
+	// Message describes a failure
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Scanned is the number of discovery topics with our origin that were checked
+	Scanned int `json:"scanned"`
+
+	// OrphanCount is the total number of orphans found
+	OrphanCount int `json:"orphanCount"`
+
+	// Orphans lists the orphans found, truncated to the first 500
+	// +optional
+	Orphans []OrphanReport `json:"orphans,omitempty"`
+}
+
+// MQTTGarbageCollectionStatus defines the observed state of MQTTGarbageCollection.
+type MQTTGarbageCollectionStatus struct {
+	// LastRun is the most recent garbage collection cycle
+	// +optional
+	LastRun *GarbageCollectionRun `json:"lastRun,omitempty"`
+
+	// ObservedTrigger is the last value of the gc-trigger annotation that was acted on
+	// +optional
+	ObservedTrigger string `json:"observedTrigger,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+
+// MQTTGarbageCollection is the Schema for the mqttgarbagecollections API.
+// It reports the results of the orphan garbage collector and accepts
+// on-demand runs through the gc-trigger annotation.
+type MQTTGarbageCollection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MQTTGarbageCollectionSpec   `json:"spec,omitempty"`
+	Status MQTTGarbageCollectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MQTTGarbageCollectionList contains a list of MQTTGarbageCollection.
+type MQTTGarbageCollectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MQTTGarbageCollection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MQTTGarbageCollection{}, &MQTTGarbageCollectionList{})
+}
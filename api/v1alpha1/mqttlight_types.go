@@ -21,6 +21,11 @@ import (
 )
 
 // MQTTLightSpec defines the desired state of MQTTLight.
+// Fields that only one schema understands may only be set when schema
+// selects it, as v1beta1 keeps them in a sub-struct of that schema.
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && !(!(has(oldSelf.value().payloadOn) || has(oldSelf.value().payloadOff) || has(oldSelf.value().brightnessCommandTopic) || has(oldSelf.value().brightnessStateTopic) || has(oldSelf.value().brightnessValueTemplate) || has(oldSelf.value().colorTempCommandTopic) || has(oldSelf.value().colorTempStateTopic) || has(oldSelf.value().colorTempValueTemplate) || has(oldSelf.value().rgbCommandTopic) || has(oldSelf.value().rgbStateTopic) || has(oldSelf.value().rgbCommandTemplate) || has(oldSelf.value().rgbValueTemplate) || has(oldSelf.value().effectCommandTopic) || has(oldSelf.value().effectStateTopic) || has(oldSelf.value().effectValueTemplate) || has(oldSelf.value().onCommandType)) || (!has(oldSelf.value().schema) || oldSelf.value().schema == 'default'))) || (!(has(self.payloadOn) || has(self.payloadOff) || has(self.brightnessCommandTopic) || has(self.brightnessStateTopic) || has(self.brightnessValueTemplate) || has(self.colorTempCommandTopic) || has(self.colorTempStateTopic) || has(self.colorTempValueTemplate) || has(self.rgbCommandTopic) || has(self.rgbStateTopic) || has(self.rgbCommandTemplate) || has(self.rgbValueTemplate) || has(self.effectCommandTopic) || has(self.effectStateTopic) || has(self.effectValueTemplate) || has(self.onCommandType)) || (!has(self.schema) || self.schema == 'default'))",message="payloadOn, payloadOff, brightnessCommandTopic, brightnessStateTopic, brightnessValueTemplate, colorTempCommandTopic, colorTempStateTopic, colorTempValueTemplate, rgbCommandTopic, rgbStateTopic, rgbCommandTemplate, rgbValueTemplate, effectCommandTopic, effectStateTopic, effectValueTemplate, onCommandType may only be set with schema default",optionalOldSelf=true
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && !(!(has(oldSelf.value().brightness) || has(oldSelf.value().colorTemp) || has(oldSelf.value().effect) || has(oldSelf.value().supportedColorModes)) || (has(oldSelf.value().schema) && oldSelf.value().schema == 'json'))) || (!(has(self.brightness) || has(self.colorTemp) || has(self.effect) || has(self.supportedColorModes)) || (has(self.schema) && self.schema == 'json'))",message="brightness, colorTemp, effect, supportedColorModes may only be set with schema json",optionalOldSelf=true
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && !(!(has(oldSelf.value().commandOnTemplate) || has(oldSelf.value().commandOffTemplate) || has(oldSelf.value().stateTemplate) || has(oldSelf.value().brightnessTemplate) || has(oldSelf.value().colorTempTemplate) || has(oldSelf.value().redTemplate) || has(oldSelf.value().greenTemplate) || has(oldSelf.value().blueTemplate)) || (has(oldSelf.value().schema) && oldSelf.value().schema == 'template'))) || (!(has(self.commandOnTemplate) || has(self.commandOffTemplate) || has(self.stateTemplate) || has(self.brightnessTemplate) || has(self.colorTempTemplate) || has(self.redTemplate) || has(self.greenTemplate) || has(self.blueTemplate)) || (has(self.schema) && self.schema == 'template'))",message="commandOnTemplate, commandOffTemplate, stateTemplate, brightnessTemplate, colorTempTemplate, redTemplate, greenTemplate, blueTemplate may only be set with schema template",optionalOldSelf=true
 type MQTTLightSpec struct {
 	CommonSpec `json:",inline"`
 
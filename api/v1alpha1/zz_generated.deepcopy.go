@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -39,6 +40,85 @@ func (in *AvailabilityConfig) DeepCopy() *AvailabilityConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMQTTDefaults) DeepCopyInto(out *ClusterMQTTDefaults) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMQTTDefaults.
+func (in *ClusterMQTTDefaults) DeepCopy() *ClusterMQTTDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMQTTDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterMQTTDefaults) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMQTTDefaultsList) DeepCopyInto(out *ClusterMQTTDefaultsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterMQTTDefaults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMQTTDefaultsList.
+func (in *ClusterMQTTDefaultsList) DeepCopy() *ClusterMQTTDefaultsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMQTTDefaultsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterMQTTDefaultsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMQTTDefaultsSpec) DeepCopyInto(out *ClusterMQTTDefaultsSpec) {
+	*out = *in
+	in.MQTTDefaultsSpec.DeepCopyInto(&out.MQTTDefaultsSpec)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMQTTDefaultsSpec.
+func (in *ClusterMQTTDefaultsSpec) DeepCopy() *ClusterMQTTDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMQTTDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 	*out = *in
@@ -68,6 +148,11 @@ func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ExtraConfig != nil {
+		in, out := &in.ExtraConfig, &out.ExtraConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonSpec.
@@ -94,6 +179,11 @@ func (in *CommonStatus) DeepCopyInto(out *CommonStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AppliedDefaults != nil {
+		in, out := &in.AppliedDefaults, &out.AppliedDefaults
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonStatus.
@@ -171,6 +261,51 @@ func (in *DeviceRef) DeepCopy() *DeviceRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntityDefaults) DeepCopyInto(out *EntityDefaults) {
+	*out = *in
+	if in.EnabledByDefault != nil {
+		in, out := &in.EnabledByDefault, &out.EnabledByDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Device != nil {
+		in, out := &in.Device, &out.Device
+		*out = new(DeviceBlock)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceRef != nil {
+		in, out := &in.DeviceRef, &out.DeviceRef
+		*out = new(DeviceRef)
+		**out = **in
+	}
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = make([]AvailabilityConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.Qos != nil {
+		in, out := &in.Qos, &out.Qos
+		*out = new(int)
+		**out = **in
+	}
+	if in.Retain != nil {
+		in, out := &in.Retain, &out.Retain
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityDefaults.
+func (in *EntityDefaults) DeepCopy() *EntityDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(EntityDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EntityMetadata) DeepCopyInto(out *EntityMetadata) {
 	*out = *in
@@ -191,6 +326,31 @@ func (in *EntityMetadata) DeepCopy() *EntityMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionRun) DeepCopyInto(out *GarbageCollectionRun) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Orphans != nil {
+		in, out := &in.Orphans, &out.Orphans
+		*out = make([]OrphanReport, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionRun.
+func (in *GarbageCollectionRun) DeepCopy() *GarbageCollectionRun {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MQTTAlarmControlPanel) DeepCopyInto(out *MQTTAlarmControlPanel) {
 	*out = *in
@@ -847,6 +1007,90 @@ func (in *MQTTCoverStatus) DeepCopy() *MQTTCoverStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTDefaults) DeepCopyInto(out *MQTTDefaults) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTDefaults.
+func (in *MQTTDefaults) DeepCopy() *MQTTDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MQTTDefaults) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTDefaultsList) DeepCopyInto(out *MQTTDefaultsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MQTTDefaults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTDefaultsList.
+func (in *MQTTDefaultsList) DeepCopy() *MQTTDefaultsList {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTDefaultsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MQTTDefaultsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTDefaultsSpec) DeepCopyInto(out *MQTTDefaultsSpec) {
+	*out = *in
+	in.EntityDefaults.DeepCopyInto(&out.EntityDefaults)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTDefaultsSpec.
+func (in *MQTTDefaultsSpec) DeepCopy() *MQTTDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MQTTDevice) DeepCopyInto(out *MQTTDevice) {
 	*out = *in
@@ -1135,6 +1379,102 @@ func (in *MQTTDeviceTriggerStatus) DeepCopy() *MQTTDeviceTriggerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTEntity) DeepCopyInto(out *MQTTEntity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTEntity.
+func (in *MQTTEntity) DeepCopy() *MQTTEntity {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTEntity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MQTTEntity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTEntityList) DeepCopyInto(out *MQTTEntityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MQTTEntity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTEntityList.
+func (in *MQTTEntityList) DeepCopy() *MQTTEntityList {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTEntityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MQTTEntityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTEntitySpec) DeepCopyInto(out *MQTTEntitySpec) {
+	*out = *in
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTEntitySpec.
+func (in *MQTTEntitySpec) DeepCopy() *MQTTEntitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTEntitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTEntityStatus) DeepCopyInto(out *MQTTEntityStatus) {
+	*out = *in
+	in.CommonStatus.DeepCopyInto(&out.CommonStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTEntityStatus.
+func (in *MQTTEntityStatus) DeepCopy() *MQTTEntityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTEntityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MQTTEvent) DeepCopyInto(out *MQTTEvent) {
 	*out = *in
@@ -1342,6 +1682,105 @@ func (in *MQTTFanStatus) DeepCopy() *MQTTFanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTGarbageCollection) DeepCopyInto(out *MQTTGarbageCollection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTGarbageCollection.
+func (in *MQTTGarbageCollection) DeepCopy() *MQTTGarbageCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTGarbageCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MQTTGarbageCollection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTGarbageCollectionList) DeepCopyInto(out *MQTTGarbageCollectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MQTTGarbageCollection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTGarbageCollectionList.
+func (in *MQTTGarbageCollectionList) DeepCopy() *MQTTGarbageCollectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTGarbageCollectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MQTTGarbageCollectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTGarbageCollectionSpec) DeepCopyInto(out *MQTTGarbageCollectionSpec) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTGarbageCollectionSpec.
+func (in *MQTTGarbageCollectionSpec) DeepCopy() *MQTTGarbageCollectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTGarbageCollectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTGarbageCollectionStatus) DeepCopyInto(out *MQTTGarbageCollectionStatus) {
+	*out = *in
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
+		*out = new(GarbageCollectionRun)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTGarbageCollectionStatus.
+func (in *MQTTGarbageCollectionStatus) DeepCopy() *MQTTGarbageCollectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTGarbageCollectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MQTTHumidifier) DeepCopyInto(out *MQTTHumidifier) {
 	*out = *in
@@ -3189,3 +3628,18 @@ func (in *MQTTWaterHeaterStatus) DeepCopy() *MQTTWaterHeaterStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanReport) DeepCopyInto(out *OrphanReport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanReport.
+func (in *OrphanReport) DeepCopy() *OrphanReport {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanReport)
+	in.DeepCopyInto(out)
+	return out
+}
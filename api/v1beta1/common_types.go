@@ -0,0 +1,249 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// EntityMetadata contains common fields for all MQTT entity types.
+type EntityMetadata struct {
+	// Name is the display name in Home Assistant
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// UniqueId is the unique identifier for HA entity registry (defaults to <namespace>-<name>)
+	// +optional
+	UniqueId string `json:"uniqueId,omitempty" hass:"-"`
+
+	// Icon is the MDI icon (e.g. mdi:thermometer)
+	// +optional
+	Icon string `json:"icon,omitempty"`
+
+	// EntityCategory is the entity category
+	// +kubebuilder:validation:Enum=config;diagnostic
+	// +optional
+	EntityCategory string `json:"entityCategory,omitempty"`
+
+	// EnabledByDefault indicates whether the entity is enabled when first discovered
+	// +optional
+	EnabledByDefault *bool `json:"enabledByDefault,omitempty"`
+
+	// ObjectId is the override for HA entity ID generation. It is published
+	// as default_entity_id when targeting Home Assistant 2025.10 or later.
+	// +optional
+	ObjectId string `json:"objectId,omitempty"`
+}
+
+// DeviceBlock contains the device configuration for Home Assistant device registry.
+type DeviceBlock struct {
+	// Name is the device display name
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Identifiers is a list of identifiers (at least one of identifiers or connections is needed)
+	// +optional
+	Identifiers []string `json:"identifiers,omitempty"`
+
+	// Connections is a list of typed connections (e.g. {type: mac, value: "aa:bb:cc:dd:ee:ff"})
+	// +optional
+	Connections []DeviceConnection `json:"connections,omitempty"`
+
+	// Manufacturer is the device manufacturer
+	// +optional
+	Manufacturer string `json:"manufacturer,omitempty"`
+
+	// Model is the device model
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// ModelId is the device model identifier
+	// +optional
+	ModelId string `json:"modelId,omitempty"`
+
+	// SerialNumber is the device serial number
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// HwVersion is the hardware version
+	// +optional
+	HwVersion string `json:"hwVersion,omitempty"`
+
+	// SwVersion is the software version
+	// +optional
+	SwVersion string `json:"swVersion,omitempty"`
+
+	// SuggestedArea is the suggested area in Home Assistant (e.g. Living Room)
+	// +optional
+	SuggestedArea string `json:"suggestedArea,omitempty"`
+
+	// ConfigurationUrl is the URL for device configuration
+	// +optional
+	ConfigurationUrl string `json:"configurationUrl,omitempty"`
+
+	// ViaDevice is the identifier of device that routes messages
+	// +optional
+	ViaDevice string `json:"viaDevice,omitempty"`
+}
+
+// DeviceConnection is a connection of a device to the outside world.
+type DeviceConnection struct {
+	// Type is the connection type (e.g. mac, upnp, zigbee, bluetooth)
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+
+	// Value is the connection identifier (e.g. aa:bb:cc:dd:ee:ff)
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// DeviceRef is a reference to an MQTTDevice resource instead of inline device block.
+type DeviceRef struct {
+	// Name is the name of an MQTTDevice resource in the same namespace
+	Name string `json:"name"`
+}
+
+// AvailabilityConfig defines an availability topic configuration.
+type AvailabilityConfig struct {
+	// Topic is the MQTT topic for availability
+	Topic string `json:"topic"`
+
+	// PayloadAvailable is the payload indicating available (default: online)
+	// +optional
+	PayloadAvailable string `json:"payloadAvailable,omitempty"`
+
+	// PayloadNotAvailable is the payload indicating unavailable (default: offline)
+	// +optional
+	PayloadNotAvailable string `json:"payloadNotAvailable,omitempty"`
+
+	// ValueTemplate is the template to extract availability from payload
+	// +optional
+	ValueTemplate string `json:"valueTemplate,omitempty"`
+}
+
+// CommonSpec contains fields common to all MQTT entity specs.
+// Fields tagged hass:"-" are not copied into the discovery payload as they
+// are; the controller resolves them or does not send them to Home Assistant.
+type CommonSpec struct {
+	EntityMetadata `json:",inline"`
+
+	// Device is the device configuration for Home Assistant device registry
+	// +optional
+	Device *DeviceBlock `json:"device,omitempty" hass:"-"`
+
+	// DeviceRef is a reference to an MQTTDevice resource instead of inline device block
+	// +optional
+	DeviceRef *DeviceRef `json:"deviceRef,omitempty" hass:"-"`
+
+	// Availability is a list of availability topics
+	// +optional
+	Availability []AvailabilityConfig `json:"availability,omitempty" hass:"-"`
+
+	// AvailabilityTopic is a simple availability topic (shorthand for single availability)
+	// +optional
+	AvailabilityTopic string `json:"availabilityTopic,omitempty"`
+
+	// AvailabilityMode is how to combine multiple availability topics
+	// +kubebuilder:validation:Enum=all;any;latest
+	// +optional
+	AvailabilityMode string `json:"availabilityMode,omitempty" hass:"-"`
+
+	// Qos is the MQTT QoS level
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	Qos *int `json:"qos,omitempty"`
+
+	// Retain indicates whether to retain messages on command/state topics
+	// +optional
+	Retain *bool `json:"retain,omitempty"`
+
+	// Encoding is the payload encoding (default: utf-8)
+	// +optional
+	Encoding string `json:"encoding,omitempty"`
+
+	// JsonAttributesTopic is the MQTT topic for JSON attributes
+	// +optional
+	JsonAttributesTopic string `json:"jsonAttributesTopic,omitempty"`
+
+	// JsonAttributesTemplate is the template to extract attributes from payload
+	// +optional
+	JsonAttributesTemplate string `json:"jsonAttributesTemplate,omitempty"`
+
+	// RediscoverInterval is how often to re-publish the discovery config payload (e.g. 5m, 1h)
+	// +optional
+	RediscoverInterval string `json:"rediscoverInterval,omitempty" hass:"-"`
+
+	// ExtraConfig is merged into the discovery payload as it is, for options
+	// the typed fields do not cover yet. Its keys override typed fields, but
+	// not unique_id, device and origin, which the controller manages.
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	ExtraConfig *runtime.RawExtension `json:"extraConfig,omitempty" hass:"-"`
+}
+
+// CommonStatus contains fields common to all MQTT entity statuses.
+type CommonStatus struct {
+	// ObservedGeneration is the generation observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastPublished is the timestamp of last discovery publish
+	// +optional
+	LastPublished *metav1.Time `json:"lastPublished,omitempty"`
+
+	// DiscoveryTopic is the MQTT discovery topic path
+	// +optional
+	DiscoveryTopic string `json:"discoveryTopic,omitempty"`
+
+	// Conditions is the list of conditions for this resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// AppliedDefaults lists the MQTTDefaults and ClusterMQTTDefaults that
+	// select the resource, as MQTTDefaults/<name> or ClusterMQTTDefaults/<name>
+	// +optional
+	AppliedDefaults []string `json:"appliedDefaults,omitempty"`
+}
+
+// ConditionType constants for status conditions.
+const (
+	ConditionTypePublished     = "Published"
+	ConditionTypeMQTTConnected = "MQTTConnected"
+	// ConditionTypeDrifted is True while the retained discovery config on the
+	// broker differs from the one published for the resource.
+	ConditionTypeDrifted = "Drifted"
+	// ConditionTypeInvalidPayload is True while the discovery payload built
+	// for the resource is refused because Home Assistant would reject it.
+	ConditionTypeInvalidPayload = "InvalidPayload"
+	// ConditionTypeIncompatibleClasses is True while the device class, unit
+	// and state class of the resource do not go together in Home Assistant.
+	ConditionTypeIncompatibleClasses = "IncompatibleClasses"
+	// ConditionTypeExtraConfigOverrides is True while extraConfig sets keys
+	// of typed fields or keys the controller manages.
+	ConditionTypeExtraConfigOverrides = "ExtraConfigOverrides"
+	// ConditionTypeDeprecatedKeys is True while the spec sets keys that are
+	// deprecated or removed in the targeted Home Assistant release.
+	ConditionTypeDeprecatedKeys = "DeprecatedKeys"
+	// ConditionTypeIgnoredFields is True while the spec sets fields that are
+	// left out of the discovery payload because another field takes precedence.
+	ConditionTypeIgnoredFields = "IgnoredFields"
+)
@@ -21,6 +21,7 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"strconv"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -33,14 +34,22 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	mqttv1beta1 "github.com/spontus/hass-crds/api/v1beta1"
 	"github.com/spontus/hass-crds/internal/api"
+	"github.com/spontus/hass-crds/internal/cli"
 	"github.com/spontus/hass-crds/internal/controller"
 	"github.com/spontus/hass-crds/internal/gc"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/instance"
+	"github.com/spontus/hass-crds/internal/metrics"
 	"github.com/spontus/hass-crds/internal/mqtt"
+	"github.com/spontus/hass-crds/internal/standalone"
+	"github.com/spontus/hass-crds/internal/version"
 )
 
 var (
@@ -51,9 +60,15 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(mqttv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(mqttv1beta1.AddToScheme(scheme))
 }
 
 func main() {
+	// Subcommands such as import run once instead of starting the manager
+	if cli.IsPlugin(os.Args[0]) || len(os.Args) > 1 && cli.IsCommand(os.Args[1]) {
+		os.Exit(cli.Run(os.Args[1:], os.Stdout, os.Stderr))
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
@@ -61,7 +76,7 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metric endpoint binds to. "+
-		"Use the port :8080. If not set, it will be 0 in order to disable the metrics server")
+		"Use the port :8082. If not set, it will be 0 in order to disable the metrics server")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.StringVar(&apiAddr, "api-bind-address", ":8080", "The address the API/UI server binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -79,6 +94,11 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	// Without Kubernetes, entities are read from manifest files instead
+	if standaloneConfig := standalone.NewConfigFromEnv(); standaloneConfig.Dir != "" {
+		os.Exit(runStandalone(standaloneConfig, probeAddr, metricsAddr))
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -99,8 +119,22 @@ func main() {
 		TLSOpts: tlsOpts,
 	})
 
+	// Identify this installation so several can share one broker
+	instanceConfig, err := instance.NewConfigFromEnv()
+	if err != nil {
+		setupLog.Error(err, "unable to load instance configuration")
+		os.Exit(1)
+	}
+	cacheOpts, err := instanceConfig.CacheOptions(scheme)
+	if err != nil {
+		setupLog.Error(err, "unable to configure cache")
+		os.Exit(1)
+	}
+	setupLog.Info("starting hass-crds", "version", version.Version, "instance", instanceConfig.ID, "cluster", instanceConfig.ClusterName, "selector", instanceConfig.Selector)
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
+		Cache:  cacheOpts,
 		Metrics: metricsserver.Options{
 			BindAddress:   metricsAddr,
 			SecureServing: secureMetrics,
@@ -109,7 +143,7 @@ func main() {
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "448380ef.home-assistant.io",
+		LeaderElectionID:       instanceConfig.Name("448380ef.home-assistant.io"),
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -134,7 +168,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	mqttClient := mqtt.NewClient(mqttConfig, setupLog)
+	mqttClient := mqtt.New(mqttConfig, setupLog)
 
 	// Connect to MQTT broker
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -144,23 +178,98 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Reload MQTT credentials when the mounted Secret is rotated
+	if updater, ok := mqttClient.(mqtt.CredentialsUpdater); ok && mqttConfig.CredentialsDir != "" {
+		watcher := mqtt.NewCredentialsWatcher(mqttConfig, updater, setupLog)
+		if err := mgr.Add(watcher); err != nil {
+			setupLog.Error(err, "unable to register MQTT credentials watcher")
+			os.Exit(1)
+		}
+	}
+
+	// Queue publishes while the broker is unreachable instead of blocking reconciles
+	outbox, err := mqtt.NewOutbox(mqttClient, mqttConfig, setupLog)
+	if err != nil {
+		setupLog.Error(err, "unable to create MQTT publish outbox")
+		os.Exit(1)
+	}
+	if err := mgr.Add(outbox); err != nil {
+		setupLog.Error(err, "unable to register MQTT publish outbox")
+		os.Exit(1)
+	}
+
+	// Build payloads for the oldest Home Assistant release in use
+	targetVersion, err := hass.ParseVersion(os.Getenv("TARGET_HA_VERSION"))
+	if err != nil {
+		setupLog.Error(err, "invalid TARGET_HA_VERSION")
+		os.Exit(1)
+	}
+
 	// Setup all controllers
-	if err := controller.SetupAllControllers(mgr, mqttClient, setupLog); err != nil {
+	controllerOpts := controller.Options{
+		InstanceID:    instanceConfig.ID,
+		ClusterName:   instanceConfig.ClusterName,
+		TargetVersion: targetVersion,
+	}
+
+	// Repair discovery configs overwritten or cleared by other publishers,
+	// unless DRIFT_DETECTION turns it off
+	driftDetection := true
+	if v := os.Getenv("DRIFT_DETECTION"); v != "" {
+		driftDetection, err = strconv.ParseBool(v)
+		if err != nil {
+			setupLog.Error(err, "invalid DRIFT_DETECTION")
+			os.Exit(1)
+		}
+	}
+	if driftDetection {
+		controllerOpts.Drift = controller.NewDriftDetector(mgr.GetClient(), outbox, setupLog)
+		if err := mgr.Add(controllerOpts.Drift); err != nil {
+			setupLog.Error(err, "unable to register drift detector")
+			os.Exit(1)
+		}
+	}
+	if err := controller.SetupAllControllers(mgr, outbox, controllerOpts, setupLog); err != nil {
 		setupLog.Error(err, "unable to setup controllers")
 		os.Exit(1)
 	}
 
+	// Convert between API versions and check entities for the API server,
+	// unless disabled to run outside the cluster without serving certificates
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := controller.SetupWebhooks(mgr); err != nil {
+			setupLog.Error(err, "unable to setup webhooks")
+			os.Exit(1)
+		}
+	}
+
+	// Report entity counts from the manager's cache on every scrape
+	if err := ctrlmetrics.Registry.Register(metrics.NewEntityCollector(mgr.GetClient(), mgr.GetScheme(), setupLog)); err != nil {
+		setupLog.Error(err, "unable to register entity metrics")
+		os.Exit(1)
+	}
+
 	// Register orphan garbage collector
 	gcConfig := gc.NewConfigFromEnv()
-	collector := gc.NewOrphanCollector(mgr.GetClient(), mqttClient, setupLog, gcConfig)
+	gcConfig.InstanceID = instanceConfig.ID
+	gcConfig.Selector = instanceConfig.Selector
+	gcConfig.ClusterName = instanceConfig.ClusterName
+	collector := gc.NewOrphanCollector(mgr.GetClient(), outbox, mgr.GetEventRecorderFor("hass-crds-gc"), setupLog, gcConfig)
 	if err := mgr.Add(collector); err != nil {
 		setupLog.Error(err, "unable to register orphan garbage collector")
 		os.Exit(1)
 	}
+	if err := gc.NewTriggerReconciler(mgr.GetClient(), collector).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup garbage collection trigger controller")
+		os.Exit(1)
+	}
 
 	// Setup signal handler once for both API server and manager
 	signalCtx := ctrl.SetupSignalHandler()
 
+	// Readiness follows the broker connection, tolerating short outages
+	mqttChecker := mqtt.NewConnectionChecker(mqttClient, mqttConfig.ReadyGracePeriod)
+
 	// Start API server if address is configured
 	if apiAddr != "" {
 		apiServer, err := api.NewServer(apiAddr, mgr.GetClient(), ctrl.GetConfigOrDie(), setupLog)
@@ -168,6 +277,13 @@ func main() {
 			setupLog.Error(err, "unable to create API server")
 			os.Exit(1)
 		}
+		apiServer.AddHealthCheck("mqtt", mqttChecker.Check)
+		apiServer.AddHealthCheck("gc", collector.HealthCheck)
+		if gcConfig.Enabled {
+			apiServer.EnableGarbageCollection(collector.ReportName())
+		}
+		apiServer.EnableImport(outbox)
+		apiServer.EnableExport(instanceConfig.ClusterName, instanceConfig.ID)
 		go func() {
 			if err := apiServer.Start(signalCtx); err != nil {
 				setupLog.Error(err, "API server error")
@@ -179,8 +295,12 @@ func main() {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
-	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
-		setupLog.Error(err, "unable to set up ready check")
+	if err := mgr.AddReadyzCheck("mqtt", mqttChecker.Check); err != nil {
+		setupLog.Error(err, "unable to set up MQTT ready check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("gc", collector.HealthCheck); err != nil {
+		setupLog.Error(err, "unable to set up GC ready check")
 		os.Exit(1)
 	}
 
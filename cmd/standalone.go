@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/spontus/hass-crds/internal/gc"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/instance"
+	"github.com/spontus/hass-crds/internal/metrics"
+	"github.com/spontus/hass-crds/internal/mqtt"
+	"github.com/spontus/hass-crds/internal/standalone"
+)
+
+// runStandalone publishes the entities of the manifests in config.Dir
+// without Kubernetes until a signal is received, and returns the exit code.
+// The orphan collector, outbox and credentials watcher run as in the
+// controller, with the manifests in place of the API server.
+func runStandalone(config standalone.Config, probeAddr, metricsAddr string) int {
+	log := ctrl.Log.WithName("setup")
+
+	instanceConfig, err := instance.NewConfigFromEnv()
+	if err != nil {
+		log.Error(err, "unable to load instance configuration")
+		return 1
+	}
+	log.Info("starting hass-crds in standalone mode", "dir", config.Dir, "instance", instanceConfig.ID, "cluster", instanceConfig.ClusterName)
+
+	mqttConfig, err := mqtt.NewConfigFromEnv()
+	if err != nil {
+		log.Error(err, "unable to load MQTT configuration")
+		return 1
+	}
+	mqttClient := mqtt.New(mqttConfig, log)
+	defer mqttClient.Disconnect()
+
+	connectCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := mqttClient.Connect(connectCtx); err != nil {
+		log.Error(err, "unable to connect to MQTT broker")
+		return 1
+	}
+
+	outbox, err := mqtt.NewOutbox(mqttClient, mqttConfig, log)
+	if err != nil {
+		log.Error(err, "unable to create MQTT publish outbox")
+		return 1
+	}
+
+	config.TargetVersion, err = hass.ParseVersion(os.Getenv("TARGET_HA_VERSION"))
+	if err != nil {
+		log.Error(err, "invalid TARGET_HA_VERSION")
+		return 1
+	}
+
+	runner, err := standalone.NewRunner(outbox, config, instanceConfig, log)
+	if err != nil {
+		log.Error(err, "unable to create standalone runner")
+		return 1
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+
+	// The first sync must succeed so the collector never sees an empty file set
+	if err := runner.Sync(ctx); err != nil {
+		log.Error(err, "unable to sync manifests")
+		return 1
+	}
+
+	runnables := []interface{ Start(context.Context) error }{outbox}
+	if updater, ok := mqttClient.(mqtt.CredentialsUpdater); ok && mqttConfig.CredentialsDir != "" {
+		runnables = append(runnables, mqtt.NewCredentialsWatcher(mqttConfig, updater, log))
+	}
+
+	gcConfig := gc.NewConfigFromEnv()
+	gcConfig.InstanceID = instanceConfig.ID
+	gcConfig.Selector = instanceConfig.Selector
+	gcConfig.ClusterName = instanceConfig.ClusterName
+	collector := gc.NewOrphanCollector(runner.Client(), outbox, standalone.NewEventRecorder(log), log, gcConfig)
+	runnables = append(runnables, collector)
+
+	for _, r := range runnables {
+		go func() {
+			if err := r.Start(ctx); err != nil {
+				log.Error(err, "background task failed")
+			}
+		}()
+	}
+
+	mqttChecker := mqtt.NewConnectionChecker(mqttClient, mqttConfig.ReadyGracePeriod)
+	if probeAddr != "" && probeAddr != "0" {
+		live := http.StripPrefix("/healthz", &healthz.Handler{Checks: map[string]healthz.Checker{"ping": healthz.Ping}})
+		ready := http.StripPrefix("/readyz", &healthz.Handler{Checks: map[string]healthz.Checker{"mqtt": mqttChecker.Check, "gc": collector.HealthCheck}})
+		mux := http.NewServeMux()
+		mux.Handle("/healthz", live)
+		mux.Handle("/healthz/", live)
+		mux.Handle("/readyz", ready)
+		mux.Handle("/readyz/", ready)
+		serve(ctx, probeAddr, mux, "health probe")
+	}
+	if metricsAddr != "" && metricsAddr != "0" {
+		if err := ctrlmetrics.Registry.Register(metrics.NewEntityCollector(runner.Client(), runner.Client().Scheme(), log)); err != nil {
+			log.Error(err, "unable to register entity metrics")
+			return 1
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(ctrlmetrics.Registry, promhttp.HandlerOpts{}))
+		serve(ctx, metricsAddr, mux, "metrics")
+	}
+
+	if err := runner.Start(ctx); err != nil {
+		log.Error(err, "problem running standalone mode")
+		return 1
+	}
+	return 0
+}
+
+// serve runs an HTTP server on addr until ctx is done.
+func serve(ctx context.Context, addr string, handler http.Handler, name string) {
+	server := &http.Server{Addr: addr, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			ctrl.Log.WithName("setup").Error(err, "server error", "server", name)
+		}
+	}()
+}
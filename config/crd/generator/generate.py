@@ -1,6 +1,7 @@
 #!/usr/bin/env python3
 """Generate Kubernetes CRDs for Home Assistant MQTT entities."""
 
+import copy
 import os
 import sys
 from pathlib import Path
@@ -10,11 +11,23 @@ import yaml
 # Add the generator directory to the path
 sys.path.insert(0, str(Path(__file__).parent))
 
+from schemas import v1beta1
 from schemas.common import STATUS_SCHEMA, get_all_common_properties
 from schemas.entities import ALL_ENTITIES
 
 API_GROUP = "mqtt.home-assistant.io"
 API_VERSION = "v1alpha1"
+STORAGE_VERSION = "v1beta1"
+
+# The conversion webhook is served by the controller behind this Service, with
+# the serving certificate cert-manager injects into the CRDs. Both match the
+# names kustomize gives them in config/default.
+WEBHOOK_SERVICE = {
+    "namespace": "hass-crds-system",
+    "name": "hass-crds-webhook-service",
+    "path": "/convert",
+}
+SERVING_CERTIFICATE = "hass-crds-system/hass-crds-serving-cert"
 
 
 def build_spec_schema(entity: dict, include_common: bool = True) -> dict:
@@ -44,6 +57,9 @@ def build_spec_schema(entity: dict, include_common: bool = True) -> dict:
 
 def build_printer_columns(entity: dict) -> list:
     """Build printer columns for kubectl get output."""
+    if "printer_columns" in entity:
+        return entity["printer_columns"]
+
     columns = [
         {
             "name": "Name",
@@ -72,10 +88,60 @@ def build_printer_columns(entity: dict) -> list:
     return columns
 
 
+def build_version(entity: dict, name: str, spec: dict, status: dict) -> dict:
+    """Build one served version of a CRD."""
+    version = {
+        "name": name,
+        "served": True,
+        "storage": name == STORAGE_VERSION or entity.get("single_version", False),
+        "additionalPrinterColumns": build_printer_columns(entity),
+        "schema": {
+            "openAPIV3Schema": {
+                "type": "object",
+                "description": entity["description"],
+                "properties": {
+                    "apiVersion": {
+                        "type": "string",
+                        "description": "APIVersion defines the versioned schema of this representation of an object",
+                    },
+                    "kind": {
+                        "type": "string",
+                        "description": "Kind is a string value representing the REST resource this object represents",
+                    },
+                    "metadata": {
+                        "type": "object",
+                    },
+                    "spec": spec,
+                    "status": status,
+                },
+            },
+        },
+        "subresources": {
+            "status": {},
+        },
+    }
+    # Resources the controller only reads have no status
+    if not entity.get("has_status", True):
+        del version["schema"]["openAPIV3Schema"]["properties"]["status"]
+        del version["subresources"]
+    # Versions share schemas; copy them so the YAML has no anchors
+    return copy.deepcopy(version)
+
+
 def build_crd(entity: dict) -> dict:
     """Build a complete CRD definition for an entity type."""
     # Determine if this is a special utility type (MQTTDevice)
     is_utility = entity["component"] is None
+    spec = build_spec_schema(entity, include_common=not is_utility)
+    if entity["kind"] == "MQTTLight":
+        spec["x-kubernetes-validations"] = v1beta1.flat_light_validations()
+    versions = [build_version(entity, API_VERSION, spec, entity.get("status_schema", STATUS_SCHEMA))]
+    if not entity.get("single_version"):
+        versions.append(build_version(
+            entity, STORAGE_VERSION,
+            v1beta1.build_spec_schema(entity["kind"], spec),
+            entity.get("status_schema", v1beta1.STATUS_SCHEMA),
+        ))
 
     crd = {
         "apiVersion": "apiextensions.k8s.io/v1",
@@ -98,42 +164,22 @@ def build_crd(entity: dict) -> dict:
                 "plural": entity["plural"],
                 "singular": entity["singular"],
             },
-            "scope": "Namespaced",
-            "versions": [
-                {
-                    "name": API_VERSION,
-                    "served": True,
-                    "storage": True,
-                    "additionalPrinterColumns": build_printer_columns(entity),
-                    "schema": {
-                        "openAPIV3Schema": {
-                            "type": "object",
-                            "description": entity["description"],
-                            "properties": {
-                                "apiVersion": {
-                                    "type": "string",
-                                    "description": "APIVersion defines the versioned schema of this representation of an object",
-                                },
-                                "kind": {
-                                    "type": "string",
-                                    "description": "Kind is a string value representing the REST resource this object represents",
-                                },
-                                "metadata": {
-                                    "type": "object",
-                                },
-                                "spec": build_spec_schema(entity, include_common=not is_utility),
-                                "status": STATUS_SCHEMA,
-                            },
-                        },
-                    },
-                    "subresources": {
-                        "status": {},
-                    },
-                },
-            ],
+            "scope": entity.get("scope", "Namespaced"),
+            "versions": versions,
         },
     }
 
+    # Convert between versions through the controller
+    if len(versions) > 1:
+        crd["metadata"]["annotations"]["cert-manager.io/inject-ca-from"] = SERVING_CERTIFICATE
+        crd["spec"]["conversion"] = {
+            "strategy": "Webhook",
+            "webhook": {
+                "clientConfig": {"service": WEBHOOK_SERVICE},
+                "conversionReviewVersions": ["v1"],
+            },
+        }
+
     # Add short names if defined
     if entity.get("short_names"):
         crd["spec"]["names"]["shortNames"] = entity["short_names"]
@@ -167,7 +213,7 @@ def concatenate_crds(files: list, output_file: Path) -> None:
     with open(output_file, "w") as outf:
         outf.write("# Generated CRDs for hass-crds\n")
         outf.write(f"# API Group: {API_GROUP}\n")
-        outf.write(f"# Version: {API_VERSION}\n")
+        outf.write(f"# Versions: {API_VERSION}, {STORAGE_VERSION} (storage)\n")
         outf.write(f"# Total CRDs: {len(files)}\n")
         outf.write("#\n")
         outf.write("# Install with: kubectl apply -f crds.yaml\n")
@@ -184,6 +184,15 @@ REDISCOVERY = {
     },
 }
 
+EXTRA_CONFIG = {
+    "extraConfig": {
+        "type": "object",
+        "description": "Discovery keys merged into the payload as they are, for options without a typed field. "
+        "They override typed fields, but not uniqueId, device and origin",
+        "x-kubernetes-preserve-unknown-fields": True,
+    },
+}
+
 # Status subresource schema
 STATUS_SCHEMA = {
     "type": "object",
@@ -197,6 +206,11 @@ STATUS_SCHEMA = {
             "type": "string",
             "description": "MQTT discovery topic path",
         },
+        "appliedDefaults": {
+            "type": "array",
+            "items": {"type": "string"},
+            "description": "MQTTDefaults and ClusterMQTTDefaults that select the resource",
+        },
         "conditions": {
             "type": "array",
             "items": {
@@ -228,6 +242,51 @@ STATUS_SCHEMA = {
 }
 
 
+# Label selector (metav1.LabelSelector)
+LABEL_SELECTOR = {
+    "type": "object",
+    "properties": {
+        "matchLabels": {
+            "type": "object",
+            "additionalProperties": {"type": "string"},
+        },
+        "matchExpressions": {
+            "type": "array",
+            "items": {
+                "type": "object",
+                "properties": {
+                    "key": {"type": "string"},
+                    "operator": {"type": "string"},
+                    "values": {
+                        "type": "array",
+                        "items": {"type": "string"},
+                    },
+                },
+                "required": ["key", "operator"],
+            },
+        },
+    },
+    "x-kubernetes-map-type": "atomic",
+}
+
+
+def get_entity_defaults_properties() -> dict:
+    """Returns the common properties MQTTDefaults and ClusterMQTTDefaults can set."""
+    props = {
+        "entityCategory": ENTITY_METADATA["entityCategory"],
+        "enabledByDefault": ENTITY_METADATA["enabledByDefault"],
+    }
+    props.update(DEVICE_BLOCK)
+    props["deviceRef"] = {
+        **DEVICE_REF["deviceRef"],
+        "description": "Reference to an MQTTDevice resource in the namespace of the entity",
+    }
+    props.update(AVAILABILITY)
+    props.update(MQTT_OPTIONS)
+    props.update(REDISCOVERY)
+    return props
+
+
 def get_all_common_properties() -> dict:
     """Returns all common properties merged together."""
     props = {}
@@ -238,4 +297,5 @@ def get_all_common_properties() -> dict:
     props.update(MQTT_OPTIONS)
     props.update(JSON_ATTRIBUTES)
     props.update(REDISCOVERY)
+    props.update(EXTRA_CONFIG)
     return props
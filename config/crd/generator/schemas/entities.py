@@ -1,5 +1,7 @@
 """Entity type definitions for all MQTT CRDs."""
 
+from .common import LABEL_SELECTOR, get_entity_defaults_properties
+
 # MQTTDevice - utility resource for shared device definitions
 MQTT_DEVICE = {
     "kind": "MQTTDevice",
@@ -1845,6 +1847,234 @@ MQTT_EVENT = {
 
 
 # All entities in order of complexity (for phased generation)
+# MQTTGarbageCollection - cluster-scoped report of the orphan garbage collector
+MQTT_GARBAGE_COLLECTION = {
+    "kind": "MQTTGarbageCollection",
+    "singular": "mqttgarbagecollection",
+    "plural": "mqttgarbagecollections",
+    "short_names": ["mqttgc"],
+    "component": None,  # No HA component - operational resource
+    "scope": "Cluster",
+    "single_version": True,  # Served as v1alpha1 only; it is not an entity
+    "description": "Orphan garbage collector report and on-demand trigger",
+    "properties": {
+        "dryRun": {
+            "type": "boolean",
+            "description": "Report orphans without removing them. Overrides GC_DRY_RUN when set",
+        },
+    },
+    "required": [],
+    "printer_columns": [
+        {
+            "name": "Dry Run",
+            "type": "boolean",
+            "description": "Whether the last run only reported orphans",
+            "jsonPath": ".status.lastRun.dryRun",
+        },
+        {
+            "name": "Result",
+            "type": "string",
+            "description": "Result of the last run",
+            "jsonPath": ".status.lastRun.result",
+        },
+        {
+            "name": "Orphans",
+            "type": "integer",
+            "description": "Orphans found by the last run",
+            "jsonPath": ".status.lastRun.orphanCount",
+        },
+        {
+            "name": "Last Run",
+            "type": "date",
+            "description": "When the last run completed",
+            "jsonPath": ".status.lastRun.completionTime",
+        },
+    ],
+    "status_schema": {
+        "type": "object",
+        "properties": {
+            "observedTrigger": {
+                "type": "string",
+                "description": "Last value of the gc-trigger annotation that was acted on",
+            },
+            "lastRun": {
+                "type": "object",
+                "description": "Most recent garbage collection cycle",
+                "properties": {
+                    "startTime": {"type": "string", "format": "date-time"},
+                    "completionTime": {"type": "string", "format": "date-time"},
+                    "trigger": {
+                        "type": "string",
+                        "enum": ["Startup", "Scheduled", "Manual"],
+                    },
+                    "dryRun": {"type": "boolean"},
+                    "result": {
+                        "type": "string",
+                        "enum": ["Succeeded", "Failed", "Refused"],
+                    },
+                    "message": {"type": "string"},
+                    "scanned": {
+                        "type": "integer",
+                        "description": "Discovery topics with our origin that were checked",
+                    },
+                    "orphanCount": {
+                        "type": "integer",
+                        "description": "Total number of orphans found",
+                    },
+                    "orphans": {
+                        "type": "array",
+                        "description": "Orphans found, truncated to the first 500",
+                        "items": {
+                            "type": "object",
+                            "properties": {
+                                "topic": {"type": "string"},
+                                "component": {"type": "string"},
+                                "originName": {"type": "string"},
+                                "originSwVersion": {"type": "string"},
+                                "observedCycles": {
+                                    "type": "integer",
+                                    "description": "Consecutive cycles that found this orphan",
+                                },
+                                "action": {
+                                    "type": "string",
+                                    "enum": [
+                                        "Removed",
+                                        "WouldRemove",
+                                        "RemoveFailed",
+                                        "Pending",
+                                        "Protected",
+                                        "Refused",
+                                    ],
+                                },
+                                "error": {"type": "string"},
+                            },
+                            "required": ["topic", "action"],
+                        },
+                    },
+                },
+                "required": ["startTime", "trigger", "dryRun", "result", "scanned", "orphanCount"],
+            },
+        },
+    },
+}
+
+
+# MQTTDefaults - shared fields merged into the entities of a namespace
+MQTT_DEFAULTS = {
+    "kind": "MQTTDefaults",
+    "singular": "mqttdefaults",
+    "plural": "mqttdefaults",
+    "short_names": [],
+    "component": None,  # No HA component - operational resource
+    "single_version": True,  # Served as v1alpha1 only; it is not an entity
+    "has_status": False,
+    "description": "Shared fields merged into the entities of a namespace that leave them unset",
+    "properties": {
+        **get_entity_defaults_properties(),
+        "selector": {
+            **LABEL_SELECTOR,
+            "description": "Selects the entities the defaults apply to by their labels. All entities when unset",
+        },
+        "kinds": {
+            "type": "array",
+            "items": {"type": "string"},
+            "description": "Limits the defaults to entities of these kinds (e.g. MQTTSensor). All kinds when empty",
+        },
+        "suggestedAreaLabel": {
+            "type": "string",
+            "description": "Label of the namespace of the entity whose value is used as device.suggestedArea "
+            "when the device of the entity has none",
+        },
+    },
+    "required": [],
+    "printer_columns": [
+        {
+            "name": "Age",
+            "type": "date",
+            "jsonPath": ".metadata.creationTimestamp",
+        },
+    ],
+}
+
+# ClusterMQTTDefaults - shared fields merged into the entities of selected namespaces
+MQTT_CLUSTER_DEFAULTS = {
+    "kind": "ClusterMQTTDefaults",
+    "singular": "clustermqttdefaults",
+    "plural": "clustermqttdefaults",
+    "short_names": [],
+    "component": None,  # No HA component - operational resource
+    "scope": "Cluster",
+    "single_version": True,  # Served as v1alpha1 only; it is not an entity
+    "has_status": False,
+    "description": "Shared fields merged into the entities of the selected namespaces that leave them unset",
+    "properties": {
+        **MQTT_DEFAULTS["properties"],
+        "namespaceSelector": {
+            **LABEL_SELECTOR,
+            "description": "Selects the namespaces whose entities the defaults apply to by their labels. "
+            "All namespaces when unset",
+        },
+    },
+    "required": [],
+    "printer_columns": MQTT_DEFAULTS["printer_columns"],
+}
+
+
+# MQTTEntity
+MQTT_ENTITY = {
+    "kind": "MQTTEntity",
+    "singular": "mqttentity",
+    "plural": "mqttentities",
+    "short_names": [],
+    "component": "*",  # Set per resource in spec.component
+    "description": "Entity of any Home Assistant MQTT platform, for platforms and options without a typed kind",
+    "properties": {
+        "component": {
+            "type": "string",
+            "description": "Home Assistant MQTT platform to publish the entity as (e.g. siren)",
+            "pattern": "^[a-z0-9_]+$",
+        },
+        "config": {
+            "type": "object",
+            "description": "Platform-specific discovery keys merged into the payload as they are, before extraConfig. "
+            "uniqueId, device and origin are managed by the controller",
+            "x-kubernetes-preserve-unknown-fields": True,
+        },
+    },
+    "required": ["component"],
+    "printer_columns": [
+        {
+            "name": "Component",
+            "type": "string",
+            "description": "Home Assistant MQTT platform",
+            "jsonPath": ".spec.component",
+        },
+        {
+            "name": "Name",
+            "type": "string",
+            "description": "Display name in Home Assistant",
+            "jsonPath": ".spec.name",
+        },
+        {
+            "name": "Published",
+            "type": "string",
+            "description": "Whether discovery has been published",
+            "jsonPath": ".status.conditions[?(@.type=='Published')].status",
+        },
+        {
+            "name": "Last Published",
+            "type": "date",
+            "description": "When discovery was last published",
+            "jsonPath": ".status.lastPublished",
+        },
+        {
+            "name": "Age",
+            "type": "date",
+            "jsonPath": ".metadata.creationTimestamp",
+        },
+    ],
+}
+
 ALL_ENTITIES = [
     # Phase 1: Simplest
     MQTT_DEVICE,
@@ -1879,4 +2109,9 @@ ALL_ENTITIES = [
     MQTT_DEVICE_TRACKER,
     MQTT_DEVICE_TRIGGER,
     MQTT_EVENT,
+    MQTT_ENTITY,
+    # Operational resources
+    MQTT_GARBAGE_COLLECTION,
+    MQTT_DEFAULTS,
+    MQTT_CLUSTER_DEFAULTS,
 ]
@@ -0,0 +1,189 @@
+"""Schema changes of the v1beta1 API version relative to v1alpha1.
+
+The v1beta1 schema of a kind is derived from its v1alpha1 schema: device
+connections are typed objects, the schema-specific MQTTLight fields live in
+sub-structs named after their schema, and conditions are metav1.Condition.
+The Go conversion in api/v1alpha1/convert.go mirrors these changes.
+"""
+
+import copy
+
+# Device connection as a typed object instead of a [type, value] pair
+CONNECTIONS = {
+    "type": "array",
+    "items": {
+        "type": "object",
+        "properties": {
+            "type": {
+                "type": "string",
+                "minLength": 1,
+                "description": "Connection type (e.g. mac, upnp, zigbee, bluetooth)",
+            },
+            "value": {
+                "type": "string",
+                "minLength": 1,
+                "description": "Connection identifier (e.g. aa:bb:cc:dd:ee:ff)",
+            },
+        },
+        "required": ["type", "value"],
+    },
+    "description": "List of typed connections (e.g. {type: mac, value: aa:bb:cc:dd:ee:ff})",
+}
+
+# MQTTLight fields understood by one schema only, by sub-struct
+LIGHT_SCHEMA_FIELDS = {
+    "default": [
+        "payloadOn", "payloadOff",
+        "brightnessCommandTopic", "brightnessStateTopic", "brightnessValueTemplate",
+        "colorTempCommandTopic", "colorTempStateTopic", "colorTempValueTemplate",
+        "rgbCommandTopic", "rgbStateTopic", "rgbCommandTemplate", "rgbValueTemplate",
+        "effectCommandTopic", "effectStateTopic", "effectValueTemplate",
+        "onCommandType",
+    ],
+    "json": [
+        "brightness", "colorTemp", "effect", "supportedColorModes",
+    ],
+    "template": [
+        "commandOnTemplate", "commandOffTemplate", "stateTemplate",
+        "brightnessTemplate", "colorTempTemplate",
+        "redTemplate", "greenTemplate", "blueTemplate",
+    ],
+}
+
+
+def _selects(obj: str, schema: str) -> str:
+    """CEL expression that is true when the light obj selects schema."""
+    if schema == "default":
+        return f"!has({obj}.schema) || {obj}.schema == 'default'"
+    return f"has({obj}.schema) && {obj}.schema == '{schema}'"
+
+
+def _ratcheted(check, message: str) -> dict:
+    """Validation enforcing check on creates and on updates of objects that
+    passed it already. Lights created before the check keep being accepted
+    until they are fixed, so that their other fields can still be updated.
+    """
+    return {
+        "rule": f"(oldSelf.hasValue() && !({check('oldSelf.value()')})) || ({check('self')})",
+        "message": message,
+        "optionalOldSelf": True,
+    }
+
+
+def light_validations() -> list:
+    """Validations of the v1beta1 MQTTLight spec: a sub-struct may only be
+    set with the schema it is named after."""
+    return [
+        _ratcheted(
+            lambda obj, schema=schema: f"!has({obj}.{schema}) || ({_selects(obj, schema)})",
+            f"{schema} may only be set with schema {schema}",
+        )
+        for schema in LIGHT_SCHEMA_FIELDS
+    ]
+
+
+def flat_light_validations() -> list:
+    """Validations of the v1alpha1 MQTTLight spec.
+
+    The fields of a schema may only be set with that schema, so that every
+    v1alpha1 light converts to a v1beta1 light light_validations accept.
+    """
+    validations = []
+    for schema, fields in LIGHT_SCHEMA_FIELDS.items():
+        def check(obj, schema=schema, fields=fields):
+            any_set = " || ".join(f"has({obj}.{field})" for field in fields)
+            return f"!({any_set}) || ({_selects(obj, schema)})"
+        validations.append(_ratcheted(check, f"{', '.join(fields)} may only be set with schema {schema}"))
+    return validations
+
+
+# metav1.Condition
+CONDITION = {
+    "type": "object",
+    "properties": {
+        "type": {
+            "type": "string",
+            "maxLength": 316,
+            "pattern": r"^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$",
+            "description": "Condition type (Published, MQTTConnected, ...)",
+        },
+        "status": {
+            "type": "string",
+            "enum": ["True", "False", "Unknown"],
+        },
+        "observedGeneration": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 0,
+            "description": "Generation the condition was set for",
+        },
+        "lastTransitionTime": {
+            "type": "string",
+            "format": "date-time",
+        },
+        "reason": {
+            "type": "string",
+            "maxLength": 1024,
+            "minLength": 1,
+            "pattern": r"^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$",
+        },
+        "message": {
+            "type": "string",
+            "maxLength": 32768,
+        },
+    },
+    "required": ["type", "status", "lastTransitionTime", "reason", "message"],
+}
+
+STATUS_SCHEMA = {
+    "type": "object",
+    "properties": {
+        "observedGeneration": {
+            "type": "integer",
+            "format": "int64",
+            "description": "Generation observed by the controller",
+        },
+        "lastPublished": {
+            "type": "string",
+            "format": "date-time",
+            "description": "Timestamp of last discovery publish",
+        },
+        "discoveryTopic": {
+            "type": "string",
+            "description": "MQTT discovery topic path",
+        },
+        "appliedDefaults": {
+            "type": "array",
+            "items": {"type": "string"},
+            "description": "MQTTDefaults and ClusterMQTTDefaults that select the resource",
+        },
+        "conditions": {
+            "type": "array",
+            "items": CONDITION,
+            "x-kubernetes-list-type": "map",
+            "x-kubernetes-list-map-keys": ["type"],
+        },
+    },
+}
+
+
+def build_spec_schema(kind: str, spec: dict) -> dict:
+    """Derive the v1beta1 spec schema of kind from its v1alpha1 spec schema."""
+    spec = copy.deepcopy(spec)
+    properties = spec["properties"]
+
+    if "device" in properties:
+        properties["device"]["properties"]["connections"] = copy.deepcopy(CONNECTIONS)
+    if kind == "MQTTDevice":
+        properties["connections"] = copy.deepcopy(CONNECTIONS)
+
+    if kind == "MQTTLight":
+        for schema, fields in LIGHT_SCHEMA_FIELDS.items():
+            properties[schema] = {
+                "type": "object",
+                "description": f"Fields of the {schema} schema",
+                "properties": {field: properties.pop(field) for field in fields},
+            }
+        spec["x-kubernetes-validations"] = light_validations()
+
+    return spec
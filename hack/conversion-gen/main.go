@@ -0,0 +1,156 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command conversion-gen writes the methods that make the kinds of the hub
+// API version conversion hubs, and those that convert the kinds of the
+// spoke version to and from them through convertTo and convertFrom. Every
+// root type of the hub package, other than lists, is a kind.
+//
+// Run it from the root of the repository:
+//
+//	go run ./hack/conversion-gen
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	rootMarker = "+kubebuilder:object:root=true"
+	outputFile = "zz_generated.conversion.go"
+)
+
+func main() {
+	hub := flag.String("hub", "api/v1beta1", "directory of the hub API version")
+	spoke := flag.String("spoke", "api/v1alpha1", "directory of the API version converted to and from the hub")
+	header := flag.String("header", "hack/boilerplate.go.txt", "file with the license header")
+	flag.Parse()
+
+	boilerplate, err := os.ReadFile(*header)
+	if err != nil {
+		log.Fatal(err)
+	}
+	kinds, err := rootTypes(*hub)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var hubMethods bytes.Buffer
+	fmt.Fprintf(&hubMethods, "// %s is the hub every other version of a kind converts to and from,\n", filepath.Base(*hub))
+	fmt.Fprintf(&hubMethods, "// and the version the API server stores objects in.\n")
+	for _, kind := range kinds {
+		fmt.Fprintf(&hubMethods, "\n// Hub marks %s as a conversion hub.\n", kind)
+		fmt.Fprintf(&hubMethods, "func (*%s) Hub() {}\n", kind)
+	}
+	if err := write(*hub, boilerplate, nil, hubMethods.Bytes()); err != nil {
+		log.Fatal(err)
+	}
+
+	var spokeMethods bytes.Buffer
+	fmt.Fprintf(&spokeMethods, "// The methods below convert every kind to and from %s, the hub and\n", filepath.Base(*hub))
+	fmt.Fprintf(&spokeMethods, "// storage version, through convertTo and convertFrom.\n")
+	for _, kind := range kinds {
+		fmt.Fprintf(&spokeMethods, "\n// ConvertTo converts this %s to the hub version.\n", kind)
+		fmt.Fprintf(&spokeMethods, "func (src *%s) ConvertTo(dst conversion.Hub) error {\n\treturn convertTo(src, dst)\n}\n", kind)
+		fmt.Fprintf(&spokeMethods, "\n// ConvertFrom converts from the hub version to this %s.\n", kind)
+		fmt.Fprintf(&spokeMethods, "func (dst *%s) ConvertFrom(src conversion.Hub) error {\n\treturn convertFrom(src, dst)\n}\n", kind)
+	}
+	imports := []string{"sigs.k8s.io/controller-runtime/pkg/conversion"}
+	if err := write(*spoke, boilerplate, imports, spokeMethods.Bytes()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// rootTypes returns the names of the root types in the package in dir,
+// other than lists, in order of name.
+func rootTypes(dir string) ([]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var kinds []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			// Markers are in the comments between a declaration and the
+			// one before it, usually apart from its doc comment
+			prev := file.Name.End()
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				marked := ok && gen.Tok == token.TYPE && hasMarker(file.Comments, prev, decl.Pos())
+				prev = decl.End()
+				if !marked {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					name := spec.(*ast.TypeSpec).Name.Name
+					if !strings.HasSuffix(name, "List") {
+						kinds = append(kinds, name)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(kinds)
+	return kinds, nil
+}
+
+// hasMarker reports whether a comment between from and to is rootMarker.
+func hasMarker(comments []*ast.CommentGroup, from, to token.Pos) bool {
+	for _, group := range comments {
+		if group.Pos() < from || group.End() > to {
+			continue
+		}
+		for _, c := range group.List {
+			if strings.TrimSpace(strings.TrimPrefix(c.Text, "//")) == rootMarker {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// write formats body as a file of the package in dir and writes it there.
+func write(dir string, boilerplate []byte, imports []string, body []byte) error {
+	var src bytes.Buffer
+	src.Write(bytes.TrimSpace(boilerplate))
+	src.WriteString("\n\n// Code generated by conversion-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", filepath.Base(dir))
+	for _, path := range imports {
+		fmt.Fprintf(&src, "import %q\n\n", path)
+	}
+	src.Write(body)
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", dir, err)
+	}
+	return os.WriteFile(filepath.Join(dir, outputFile), formatted, 0o644)
+}
@@ -51,6 +51,7 @@ func NewEntityHandler(client dynamic.Interface, restConfig *rest.Config, log log
 type EntitySummary struct {
 	Kind        string            `json:"kind"`
 	APIVersion  string            `json:"apiVersion"`
+	Component   string            `json:"component,omitempty"`
 	Name        string            `json:"name"`
 	Namespace   string            `json:"namespace"`
 	DisplayName string            `json:"displayName,omitempty"`
@@ -254,6 +255,8 @@ func (h *EntityHandler) Delete(w http.ResponseWriter, r *http.Request) {
 func (h *EntityHandler) toSummary(obj *unstructured.Unstructured) EntitySummary {
 	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
 	displayName, _, _ := unstructured.NestedString(spec, "name")
+	// Only MQTTEntity has a component in its spec; other kinds imply theirs
+	component, _, _ := unstructured.NestedString(spec, "component")
 
 	published := false
 	conditions, found, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
@@ -273,6 +276,7 @@ func (h *EntityHandler) toSummary(obj *unstructured.Unstructured) EntitySummary
 	return EntitySummary{
 		Kind:        obj.GetKind(),
 		APIVersion:  obj.GetAPIVersion(),
+		Component:   component,
 		Name:        obj.GetName(),
 		Namespace:   obj.GetNamespace(),
 		DisplayName: displayName,
@@ -77,6 +77,28 @@ func TestEntityHandler_List_FilterByKind(t *testing.T) {
 	}
 }
 
+func TestEntityHandler_List_GenericEntityComponent(t *testing.T) {
+	generic := newTestEntity("MQTTEntity", "default", "test-siren", true)
+	generic.Object["spec"].(map[string]interface{})["component"] = "siren"
+
+	handler := newTestEntityHandler(generic)
+
+	rr := executeRequest(handler.List, http.MethodGet, "/api/v1/entities?kind=MQTTEntity", nil, nil)
+
+	var response EntityListResponse
+	if err := parseJSONResponse(rr, &response); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+
+	if len(response.Items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(response.Items))
+	}
+
+	if response.Items[0].Component != "siren" {
+		t.Errorf("expected component siren, got %q", response.Items[0].Component)
+	}
+}
+
 func TestEntityHandler_List_FilterByNamespace(t *testing.T) {
 	button1 := newTestEntity("MQTTButton", "default", "button1", true)
 	button2 := newTestEntity("MQTTButton", "production", "button2", true)
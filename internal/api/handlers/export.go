@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spontus/hass-crds/internal/controller"
+	"github.com/spontus/hass-crds/internal/exporter"
+)
+
+// ExportHandler serves the resources as Home Assistant `mqtt:` YAML
+// configuration, for installations with discovery disabled.
+type ExportHandler struct {
+	client client.Client
+	base   *controller.BaseReconciler
+	log    logr.Logger
+}
+
+// NewExportHandler creates a handler that builds configurations the way the
+// controller with the given cluster name and instance ID builds discovery payloads.
+func NewExportHandler(client client.Client, clusterName, instanceID string, log logr.Logger) *ExportHandler {
+	return &ExportHandler{
+		client: client,
+		base: &controller.BaseReconciler{
+			Client:      client,
+			Log:         log,
+			ClusterName: clusterName,
+			InstanceID:  instanceID,
+		},
+		log: log.WithName("export"),
+	}
+}
+
+// Export returns the configuration of the resources in the namespace query
+// parameter, or in all namespaces without one, optionally filtered by the
+// selector label selector. It is YAML ready to paste into configuration.yaml,
+// or the entries as JSON with format=json.
+func (h *ExportHandler) Export(w http.ResponseWriter, r *http.Request) {
+	selector, err := labels.Parse(r.URL.Query().Get("selector"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid selector: "+err.Error())
+		return
+	}
+
+	objs, err := exporter.List(r.Context(), h.client, r.URL.Query().Get("namespace"), selector)
+	if err != nil {
+		h.log.Error(err, "failed to list entities")
+		writeError(w, http.StatusInternalServerError, "failed to list entities")
+		return
+	}
+
+	result, err := exporter.Export(r.Context(), h.base, objs)
+	if err != nil {
+		h.log.Error(err, "failed to export entities")
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	if r.URL.Query().Get("format") == "json" {
+		writeJSON(w, http.StatusOK, result)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	if err := result.WriteYAML(w); err != nil {
+		h.log.Error(err, "failed to write export")
+	}
+}
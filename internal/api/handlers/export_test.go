@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/exporter"
+)
+
+func newTestExportHandler() *ExportHandler {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&mqttv1alpha1.MQTTButton{
+			ObjectMeta: metav1.ObjectMeta{Name: "reboot", Namespace: "home"},
+			Spec:       mqttv1alpha1.MQTTButtonSpec{CommandTopic: "home/reboot"},
+		},
+	).Build()
+	return NewExportHandler(fakeClient, "", "", logr.Discard())
+}
+
+func TestExportHandler_Export(t *testing.T) {
+	handler := newTestExportHandler()
+
+	rr := executeRequest(handler.Export, http.MethodGet, "/api/v1/export?namespace=home", nil, nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != "application/yaml" {
+		t.Errorf("expected Content-Type application/yaml, got %s", ct)
+	}
+	if !strings.Contains(rr.Body.String(), "  button:\n") || !strings.Contains(rr.Body.String(), "command_topic: home/reboot") {
+		t.Errorf("unexpected export:\n%s", rr.Body.String())
+	}
+}
+
+func TestExportHandler_ExportJSON(t *testing.T) {
+	handler := newTestExportHandler()
+
+	rr := executeRequest(handler.Export, http.MethodGet, "/api/v1/export?namespace=lab&format=json", nil, nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var result exporter.Result
+	if err := json.Unmarshal(rr.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if len(result.Entries) != 0 {
+		t.Errorf("expected no entries in namespace lab, got %d", len(result.Entries))
+	}
+}
+
+func TestExportHandler_InvalidSelector(t *testing.T) {
+	handler := newTestExportHandler()
+
+	rr := executeRequest(handler.Export, http.MethodGet, "/api/v1/export?selector=a%3D%3D%3Db", nil, nil)
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", rr.Code)
+	}
+}
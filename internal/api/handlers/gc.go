@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+// GarbageCollectionHandler serves the orphan garbage collector report and
+// triggers on-demand runs through the MQTTGarbageCollection resource.
+type GarbageCollectionHandler struct {
+	client client.Client
+	name   string
+	log    logr.Logger
+}
+
+// NewGarbageCollectionHandler creates a handler for the MQTTGarbageCollection resource called name.
+func NewGarbageCollectionHandler(client client.Client, name string, log logr.Logger) *GarbageCollectionHandler {
+	return &GarbageCollectionHandler{
+		client: client,
+		name:   name,
+		log:    log.WithName("gc"),
+	}
+}
+
+type GarbageCollectionSummary struct {
+	Name            string                             `json:"name"`
+	DryRun          *bool                              `json:"dryRun,omitempty"`
+	Trigger         string                             `json:"trigger,omitempty"`
+	ObservedTrigger string                             `json:"observedTrigger,omitempty"`
+	LastRun         *mqttv1alpha1.GarbageCollectionRun `json:"lastRun,omitempty"`
+}
+
+func (h *GarbageCollectionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	var gc mqttv1alpha1.MQTTGarbageCollection
+	if err := h.client.Get(r.Context(), client.ObjectKey{Name: h.name}, &gc); err != nil {
+		if apierrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "garbage collection has not run yet")
+			return
+		}
+		h.log.Error(err, "failed to get MQTTGarbageCollection", "name", h.name)
+		writeError(w, http.StatusInternalServerError, "failed to get garbage collection report")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, GarbageCollectionSummary{
+		Name:            gc.Name,
+		DryRun:          gc.Spec.DryRun,
+		Trigger:         gc.Annotations[mqttv1alpha1.GarbageCollectionTriggerAnnotation],
+		ObservedTrigger: gc.Status.ObservedTrigger,
+		LastRun:         gc.Status.LastRun,
+	})
+}
+
+// Run requests a garbage collection cycle by setting the gc-trigger annotation.
+// The cycle runs asynchronously on the leader; poll Get until observedTrigger
+// matches the returned trigger.
+func (h *GarbageCollectionHandler) Run(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	trigger := time.Now().UTC().Format(time.RFC3339Nano)
+
+	var gc mqttv1alpha1.MQTTGarbageCollection
+	err := h.client.Get(ctx, client.ObjectKey{Name: h.name}, &gc)
+	switch {
+	case apierrors.IsNotFound(err):
+		gc = mqttv1alpha1.MQTTGarbageCollection{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        h.name,
+				Annotations: map[string]string{mqttv1alpha1.GarbageCollectionTriggerAnnotation: trigger},
+			},
+		}
+		err = h.client.Create(ctx, &gc)
+	case err == nil:
+		if gc.Annotations == nil {
+			gc.Annotations = map[string]string{}
+		}
+		gc.Annotations[mqttv1alpha1.GarbageCollectionTriggerAnnotation] = trigger
+		err = h.client.Update(ctx, &gc)
+	}
+	if err != nil {
+		if apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) {
+			writeError(w, http.StatusConflict, "garbage collection resource was modified, retry")
+			return
+		}
+		h.log.Error(err, "failed to trigger garbage collection", "name", h.name)
+		writeError(w, http.StatusInternalServerError, "failed to trigger garbage collection")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]string{
+		"name":    h.name,
+		"trigger": trigger,
+	})
+}
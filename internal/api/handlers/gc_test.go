@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+func newTestGarbageCollectionHandler(objects ...client.Object) (*GarbageCollectionHandler, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&mqttv1alpha1.MQTTGarbageCollection{}).
+		Build()
+
+	return NewGarbageCollectionHandler(fakeClient, "hass-crds", logr.Discard()), fakeClient
+}
+
+func TestGarbageCollectionHandler_Get(t *testing.T) {
+	handler, _ := newTestGarbageCollectionHandler(&mqttv1alpha1.MQTTGarbageCollection{
+		ObjectMeta: metav1.ObjectMeta{Name: "hass-crds"},
+		Status: mqttv1alpha1.MQTTGarbageCollectionStatus{
+			ObservedTrigger: "1",
+			LastRun: &mqttv1alpha1.GarbageCollectionRun{
+				Trigger:     mqttv1alpha1.GarbageCollectionTriggerManual,
+				Result:      mqttv1alpha1.GarbageCollectionSucceeded,
+				OrphanCount: 1,
+				Orphans:     []mqttv1alpha1.OrphanReport{{Topic: "homeassistant/button/default/old/config", Action: mqttv1alpha1.OrphanActionRemoved}},
+			},
+		},
+	})
+
+	rr := executeRequest(handler.Get, http.MethodGet, "/api/v1/gc", nil, nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var summary GarbageCollectionSummary
+	if err := json.Unmarshal(rr.Body.Bytes(), &summary); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if summary.ObservedTrigger != "1" {
+		t.Errorf("expected observedTrigger 1, got %q", summary.ObservedTrigger)
+	}
+	if summary.LastRun == nil || summary.LastRun.OrphanCount != 1 || len(summary.LastRun.Orphans) != 1 {
+		t.Errorf("expected last run with 1 orphan, got %+v", summary.LastRun)
+	}
+}
+
+func TestGarbageCollectionHandler_Get_NotFound(t *testing.T) {
+	handler, _ := newTestGarbageCollectionHandler()
+
+	rr := executeRequest(handler.Get, http.MethodGet, "/api/v1/gc", nil, nil)
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", rr.Code)
+	}
+}
+
+func TestGarbageCollectionHandler_Run(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []client.Object
+	}{
+		{name: "creates resource"},
+		{
+			name: "annotates existing resource",
+			existing: []client.Object{&mqttv1alpha1.MQTTGarbageCollection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "hass-crds",
+					Annotations: map[string]string{mqttv1alpha1.GarbageCollectionTriggerAnnotation: "old"},
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, c := newTestGarbageCollectionHandler(tt.existing...)
+
+			rr := executeRequest(handler.Run, http.MethodPost, "/api/v1/gc/run", nil, nil)
+			if rr.Code != http.StatusAccepted {
+				t.Fatalf("expected status 202, got %d: %s", rr.Code, rr.Body.String())
+			}
+
+			var response map[string]string
+			if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to parse response: %v", err)
+			}
+
+			var gc mqttv1alpha1.MQTTGarbageCollection
+			if err := c.Get(context.Background(), client.ObjectKey{Name: "hass-crds"}, &gc); err != nil {
+				t.Fatalf("failed to get resource: %v", err)
+			}
+			got := gc.Annotations[mqttv1alpha1.GarbageCollectionTriggerAnnotation]
+			if got == "" || got == "old" || got != response["trigger"] {
+				t.Errorf("expected trigger annotation %q, got %q", response["trigger"], got)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+// HealthCheck reports an error when a component is unhealthy. It has the
+// same signature as controller-runtime's healthz.Checker so the same checks
+// can back both the probes and the API.
+type HealthCheck func(r *http.Request) error
+
+type HealthHandler struct {
+	log logr.Logger
+
+	mu     sync.RWMutex
+	checks map[string]HealthCheck
+}
+
+func NewHealthHandler(log logr.Logger) *HealthHandler {
+	return &HealthHandler{
+		log:    log.WithName("health"),
+		checks: make(map[string]HealthCheck),
+	}
+}
+
+// AddCheck registers a named check reported by Health.
+func (h *HealthHandler) AddCheck(name string, check HealthCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks[name] = check
+}
+
+type CheckResult struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Live reports that the API server is serving requests.
+func (h *HealthHandler) Live(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte("ok"))
+}
+
+// Health runs every registered check and returns 503 if any of them fails.
+func (h *HealthHandler) Health(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	names := make([]string, 0, len(h.checks))
+	for name := range h.checks {
+		names = append(names, name)
+	}
+	checks := make(map[string]HealthCheck, len(h.checks))
+	for name, check := range h.checks {
+		checks[name] = check
+	}
+	h.mu.RUnlock()
+	sort.Strings(names)
+
+	healthy := true
+	results := make([]CheckResult, 0, len(names))
+	for _, name := range names {
+		result := CheckResult{Name: name, Healthy: true}
+		if err := checks[name](r); err != nil {
+			healthy = false
+			result.Healthy = false
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	status := http.StatusOK
+	if !healthy {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, map[string]interface{}{
+		"healthy": healthy,
+		"checks":  results,
+	})
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+)
+
+func TestHealthHandler_Health(t *testing.T) {
+	tests := []struct {
+		name        string
+		mqttErr     error
+		wantStatus  int
+		wantHealthy bool
+	}{
+		{name: "all healthy", wantStatus: http.StatusOK, wantHealthy: true},
+		{name: "mqtt down", mqttErr: fmt.Errorf("broker unreachable"), wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHealthHandler(logr.Discard())
+			h.AddCheck("mqtt", func(*http.Request) error { return tt.mqttErr })
+			h.AddCheck("gc", func(*http.Request) error { return nil })
+
+			rec := httptest.NewRecorder()
+			h.Health(rec, httptest.NewRequest(http.MethodGet, "/api/v1/health", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			var body struct {
+				Healthy bool          `json:"healthy"`
+				Checks  []CheckResult `json:"checks"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			if body.Healthy != tt.wantHealthy {
+				t.Errorf("healthy = %v, want %v", body.Healthy, tt.wantHealthy)
+			}
+			if len(body.Checks) != 2 || body.Checks[0].Name != "gc" || body.Checks[1].Name != "mqtt" {
+				t.Fatalf("checks = %+v, want gc and mqtt in order", body.Checks)
+			}
+			if tt.mqttErr != nil && body.Checks[1].Error != tt.mqttErr.Error() {
+				t.Errorf("mqtt error = %q, want %q", body.Checks[1].Error, tt.mqttErr.Error())
+			}
+		})
+	}
+}
+
+func TestHealthHandler_Live(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewHealthHandler(logr.Discard()).Live(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rec.Code != http.StatusOK || rec.Body.String() != "ok" {
+		t.Errorf("got %d %q, want 200 ok", rec.Code, rec.Body.String())
+	}
+}
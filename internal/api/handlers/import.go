@@ -0,0 +1,156 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spontus/hass-crds/internal/importer"
+	"github.com/spontus/hass-crds/internal/mqtt"
+)
+
+// ImportHandler converts discovery configs published by other tools into
+// resources and adopts them on request.
+type ImportHandler struct {
+	client     client.Client
+	mqttClient mqtt.Client
+	silence    time.Duration
+	log        logr.Logger
+}
+
+// NewImportHandler creates a handler scanning the broker through mqttClient.
+func NewImportHandler(client client.Client, mqttClient mqtt.Client, log logr.Logger) *ImportHandler {
+	return &ImportHandler{
+		client:     client,
+		mqttClient: mqttClient,
+		silence:    importer.DefaultSilenceTimeout,
+		log:        log.WithName("import"),
+	}
+}
+
+type ImportCandidate struct {
+	*importer.Candidate
+	Manifest map[string]interface{} `json:"manifest"`
+}
+
+type ImportResponse struct {
+	Candidates []ImportCandidate  `json:"candidates"`
+	Skipped    []importer.Skipped `json:"skipped,omitempty"`
+}
+
+type AdoptRequest struct {
+	Namespace string   `json:"namespace"`
+	Topics    []string `json:"topics"`
+}
+
+type AdoptResult struct {
+	Topic   string `json:"topic"`
+	Kind    string `json:"kind,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Adopted bool   `json:"adopted"`
+	Error   string `json:"error,omitempty"`
+}
+
+// List scans the broker and returns the foreign discovery configs as
+// resources in the namespace query parameter (default "default"). With
+// format=yaml the resources are returned as a multi-document YAML stream.
+func (h *ImportHandler) List(w http.ResponseWriter, r *http.Request) {
+	namespace := r.URL.Query().Get("namespace")
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	result, err := importer.Discover(r.Context(), h.mqttClient, namespace, h.silence)
+	if err != nil {
+		h.log.Error(err, "failed to scan broker for discovery configs")
+		writeError(w, http.StatusBadGateway, "failed to scan broker for discovery configs")
+		return
+	}
+
+	if r.URL.Query().Get("format") == "yaml" {
+		w.Header().Set("Content-Type", "application/yaml")
+		w.WriteHeader(http.StatusOK)
+		if err := importer.WriteYAML(w, result.Candidates); err != nil {
+			h.log.Error(err, "failed to write import manifests")
+		}
+		return
+	}
+
+	resp := ImportResponse{Candidates: []ImportCandidate{}, Skipped: result.Skipped}
+	for _, c := range result.Candidates {
+		manifest, err := c.Manifest()
+		if err != nil {
+			h.log.Error(err, "failed to convert import candidate", "topic", c.Topic)
+			continue
+		}
+		resp.Candidates = append(resp.Candidates, ImportCandidate{Candidate: c, Manifest: manifest})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Adopt creates resources for the requested topics and clears their original
+// configs. Each topic is adopted independently and reported in the response.
+func (h *ImportHandler) Adopt(w http.ResponseWriter, r *http.Request) {
+	var req AdoptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(req.Topics) == 0 {
+		writeError(w, http.StatusBadRequest, "topics is required")
+		return
+	}
+	if req.Namespace == "" {
+		req.Namespace = "default"
+	}
+
+	result, err := importer.Discover(r.Context(), h.mqttClient, req.Namespace, h.silence)
+	if err != nil {
+		h.log.Error(err, "failed to scan broker for discovery configs")
+		writeError(w, http.StatusBadGateway, "failed to scan broker for discovery configs")
+		return
+	}
+	candidates := make(map[string]*importer.Candidate, len(result.Candidates))
+	for _, c := range result.Candidates {
+		candidates[c.Topic] = c
+	}
+
+	results := make([]AdoptResult, 0, len(req.Topics))
+	for _, t := range req.Topics {
+		c, ok := candidates[t]
+		if !ok {
+			results = append(results, AdoptResult{Topic: t, Error: "no importable discovery config on this topic"})
+			continue
+		}
+
+		res := AdoptResult{Topic: t, Kind: c.Kind, Name: c.Name}
+		if err := importer.Adopt(r.Context(), h.client, h.mqttClient, c); err != nil {
+			res.Error = err.Error()
+		} else {
+			res.Adopted = true
+			h.log.Info("adopted discovery config", "topic", t, "kind", c.Kind, "namespace", c.Namespace, "name", c.Name)
+		}
+		results = append(results, res)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"results": results})
+}
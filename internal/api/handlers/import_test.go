@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/mqtt"
+)
+
+const importTestTopic = "homeassistant/button/legacy/reboot/config"
+
+func newTestImportHandler(t *testing.T) (*ImportHandler, client.Client, *mqtt.MockClient) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	// Deliver the retained config once the handler has subscribed
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		mockClient.SimulateMessage(importTestTopic, []byte(`{"cmd_t":"legacy/reboot","uniq_id":"reboot"}`))
+	}()
+
+	handler := NewImportHandler(fakeClient, mockClient, logr.Discard())
+	handler.silence = 100 * time.Millisecond
+	return handler, fakeClient, mockClient
+}
+
+func TestImportHandler_List(t *testing.T) {
+	handler, _, _ := newTestImportHandler(t)
+
+	rr := executeRequest(handler.List, http.MethodGet, "/api/v1/import?namespace=home", nil, nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var resp ImportResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if len(resp.Candidates) != 1 {
+		t.Fatalf("expected 1 candidate, got %d", len(resp.Candidates))
+	}
+	c := resp.Candidates[0]
+	if c.Kind != "MQTTButton" || c.Namespace != "home" || c.Name != "reboot" {
+		t.Errorf("unexpected candidate %s %s/%s", c.Kind, c.Namespace, c.Name)
+	}
+	if spec, _ := c.Manifest["spec"].(map[string]interface{}); spec["commandTopic"] != "legacy/reboot" {
+		t.Errorf("expected manifest spec.commandTopic legacy/reboot, got %v", c.Manifest["spec"])
+	}
+}
+
+func TestImportHandler_Adopt(t *testing.T) {
+	handler, c, mockClient := newTestImportHandler(t)
+
+	body := AdoptRequest{Topics: []string{importTestTopic, "homeassistant/button/legacy/missing/config"}}
+	rr := executeRequest(handler.Adopt, http.MethodPost, "/api/v1/import/adopt", body, nil)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var resp struct {
+		Results []AdoptResult `json:"results"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if len(resp.Results) != 2 || !resp.Results[0].Adopted || resp.Results[1].Adopted || resp.Results[1].Error == "" {
+		t.Errorf("expected first topic adopted and second rejected, got %+v", resp.Results)
+	}
+
+	var button mqttv1alpha1.MQTTButton
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "reboot"}, &button); err != nil {
+		t.Errorf("expected MQTTButton default/reboot: %v", err)
+	}
+	if msgs := mockClient.GetPublishedMessages(); len(msgs) != 1 || len(msgs[0].Payload) != 0 {
+		t.Errorf("expected the original config cleared, got %+v", msgs)
+	}
+}
+
+func TestImportHandler_Adopt_NoTopics(t *testing.T) {
+	handler, _, _ := newTestImportHandler(t)
+
+	rr := executeRequest(handler.Adopt, http.MethodPost, "/api/v1/import/adopt", AdoptRequest{}, nil)
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", rr.Code)
+	}
+}
@@ -27,6 +27,9 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/registry"
 )
 
 type EntityType struct {
@@ -36,37 +39,15 @@ type EntityType struct {
 	Category    string `json:"category"`
 }
 
-var entityTypes = []EntityType{
-	{Kind: "MQTTButton", Plural: "mqttbuttons", Description: "Stateless button that publishes when pressed", Category: "Controls"},
-	{Kind: "MQTTSwitch", Plural: "mqttswitches", Description: "On/off switch with state", Category: "Controls"},
-	{Kind: "MQTTScene", Plural: "mqttscenes", Description: "Scene activation", Category: "Controls"},
-	{Kind: "MQTTSelect", Plural: "mqttselects", Description: "Dropdown selector from options", Category: "Controls"},
-	{Kind: "MQTTNumber", Plural: "mqttnumbers", Description: "Numeric input with min/max", Category: "Controls"},
-	{Kind: "MQTTText", Plural: "mqtttexts", Description: "Text input field", Category: "Controls"},
-	{Kind: "MQTTSensor", Plural: "mqttsensors", Description: "Read-only sensor value", Category: "Sensors"},
-	{Kind: "MQTTBinarySensor", Plural: "mqttbinarysensors", Description: "On/off sensor state", Category: "Sensors"},
-	{Kind: "MQTTEvent", Plural: "mqttevents", Description: "Event trigger entity", Category: "Sensors"},
-	{Kind: "MQTTLight", Plural: "mqttlights", Description: "Light with brightness/color", Category: "Lighting"},
-	{Kind: "MQTTClimate", Plural: "mqttclimates", Description: "HVAC/thermostat control", Category: "Climate"},
-	{Kind: "MQTTHumidifier", Plural: "mqtthumidifiers", Description: "Humidifier/dehumidifier", Category: "Climate"},
-	{Kind: "MQTTWaterHeater", Plural: "mqttwaterheaters", Description: "Water heater control", Category: "Climate"},
-	{Kind: "MQTTFan", Plural: "mqttfans", Description: "Fan with speed control", Category: "Climate"},
-	{Kind: "MQTTLock", Plural: "mqttlocks", Description: "Lock/unlock control", Category: "Security"},
-	{Kind: "MQTTAlarmControlPanel", Plural: "mqttalarmcontrolpanels", Description: "Alarm system control", Category: "Security"},
-	{Kind: "MQTTCover", Plural: "mqttcovers", Description: "Blinds/garage doors", Category: "Covers"},
-	{Kind: "MQTTValve", Plural: "mqttvalves", Description: "Water/gas valve control", Category: "Covers"},
-	{Kind: "MQTTVacuum", Plural: "mqttvacuums", Description: "Robot vacuum control", Category: "Devices"},
-	{Kind: "MQTTLawnMower", Plural: "mqttlawnmowers", Description: "Robot lawn mower", Category: "Devices"},
-	{Kind: "MQTTSiren", Plural: "mqttsirens", Description: "Siren/alarm device", Category: "Devices"},
-	{Kind: "MQTTCamera", Plural: "mqttcameras", Description: "Camera image entity", Category: "Media"},
-	{Kind: "MQTTImage", Plural: "mqttimages", Description: "Static image entity", Category: "Media"},
-	{Kind: "MQTTNotify", Plural: "mqttnotifies", Description: "Notification service", Category: "Media"},
-	{Kind: "MQTTUpdate", Plural: "mqttupdates", Description: "Firmware update entity", Category: "Media"},
-	{Kind: "MQTTDeviceTracker", Plural: "mqttdevicetrackers", Description: "Device location tracking", Category: "Tracking"},
-	{Kind: "MQTTTag", Plural: "mqtttags", Description: "NFC/RFID tag scanner", Category: "Tracking"},
-	{Kind: "MQTTDeviceTrigger", Plural: "mqttdevicetriggers", Description: "Device automation trigger", Category: "Tracking"},
-	{Kind: "MQTTDevice", Plural: "mqttdevices", Description: "Shared device configuration", Category: "Utility"},
-}
+// entityTypes lists every kind in the registry, in the order shown in the UI.
+var entityTypes = func() []EntityType {
+	kinds := registry.All()
+	types := make([]EntityType, 0, len(kinds))
+	for _, k := range kinds {
+		types = append(types, EntityType{Kind: k.Kind, Plural: k.Plural, Description: k.Description, Category: k.Category})
+	}
+	return types
+}()
 
 func GetEntityTypes() []EntityType {
 	return entityTypes
@@ -155,9 +136,33 @@ func (h *SchemaHandler) GetSchema(w http.ResponseWriter, r *http.Request) {
 		"apiVersion":  apiGroup + "/" + apiVersion,
 		"description": et.Description,
 		"schema":      specSchema,
+		"discovery":   discoverySchema(kind),
 	})
 }
 
+// GetDiscoverySchema returns the discovery payload keys Home Assistant
+// accepts for the component of an entity kind.
+func (h *SchemaHandler) GetDiscoverySchema(w http.ResponseWriter, r *http.Request) {
+	kind := chi.URLParam(r, "kind")
+
+	schema := discoverySchema(kind)
+	if schema == nil {
+		writeError(w, http.StatusNotFound, "no discovery schema for entity type: "+kind)
+		return
+	}
+	writeJSON(w, http.StatusOK, schema)
+}
+
+// discoverySchema returns the discovery schema of kind, or nil if it is not
+// published or its component is not known.
+func discoverySchema(kind string) *hass.Component {
+	k := registry.Lookup(kind)
+	if !k.IsEntity() {
+		return nil
+	}
+	return hass.Lookup(k.Component)
+}
+
 func (h *SchemaHandler) convertJSONSchemaProps(props *apiextensionsv1.JSONSchemaProps) map[string]interface{} {
 	if props == nil {
 		return nil
@@ -21,6 +21,8 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+
+	"github.com/spontus/hass-crds/internal/hass"
 )
 
 func TestSchemaHandler_ListEntityTypes(t *testing.T) {
@@ -189,3 +191,33 @@ func TestSchemaHandler_GetSchema_UnknownKind(t *testing.T) {
 		t.Errorf("unexpected error message: %s", response["error"])
 	}
 }
+
+func TestSchemaHandler_GetDiscoverySchema(t *testing.T) {
+	handler := NewSchemaHandler(nil, logr.Discard())
+
+	rr := executeRequest(handler.GetDiscoverySchema, http.MethodGet, "/api/v1/entity-types/MQTTSelect/discovery-schema", nil, map[string]string{
+		"kind": "MQTTSelect",
+	})
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	var response hass.Component
+	if err := parseJSONResponse(rr, &response); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if response.Name != "select" || !response.Entity || response.Keys["options"] != hass.TypeList {
+		t.Errorf("unexpected schema: %+v", response)
+	}
+	if len(response.Required) != 2 {
+		t.Errorf("required = %v, want command_topic and options", response.Required)
+	}
+
+	// MQTTDevice is not published
+	rr = executeRequest(handler.GetDiscoverySchema, http.MethodGet, "/api/v1/entity-types/MQTTDevice/discovery-schema", nil, map[string]string{
+		"kind": "MQTTDevice",
+	})
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, rr.Code)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-logr/logr"
+
+	"github.com/spontus/hass-crds/internal/jinja"
+)
+
+// maxTemplateRequest bounds the size of a template render request.
+const maxTemplateRequest = 64 << 10
+
+// TemplateHandler renders Home Assistant templates against sample MQTT
+// payloads, for trying out template fields in the UI.
+type TemplateHandler struct {
+	log logr.Logger
+}
+
+func NewTemplateHandler(log logr.Logger) *TemplateHandler {
+	return &TemplateHandler{
+		log: log.WithName("templates"),
+	}
+}
+
+type RenderTemplateRequest struct {
+	Template string `json:"template"`
+	// Payload is the MQTT payload, available to the template as value and,
+	// when it is JSON, as value_json.
+	Payload string `json:"payload"`
+}
+
+type RenderTemplateResponse struct {
+	Result string `json:"result"`
+}
+
+type TemplateError struct {
+	Error string `json:"error"`
+	// Line is set for syntax errors.
+	Line int `json:"line,omitempty"`
+}
+
+// Render renders a template the way Home Assistant renders it for an
+// incoming MQTT message. Templates that fail to parse or render are
+// answered with 422 and the error.
+func (h *TemplateHandler) Render(w http.ResponseWriter, r *http.Request) {
+	var req RenderTemplateRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxTemplateRequest)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := jinja.Render(req.Template, jinja.PayloadVars(req.Payload))
+	if err != nil {
+		resp := TemplateError{Error: err.Error()}
+		var syntaxErr *jinja.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			resp.Line = syntaxErr.Line
+		}
+		writeJSON(w, http.StatusUnprocessableEntity, resp)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, RenderTemplateResponse{Result: result})
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+)
+
+func TestTemplateHandler_Render(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       interface{}
+		wantStatus int
+		wantResult string
+		wantError  string
+		wantLine   int
+	}{
+		{
+			name:       "renders against a JSON payload",
+			body:       RenderTemplateRequest{Template: "{{ value_json.temperature | float | round(1) }}", Payload: `{"temperature": "21.56"}`},
+			wantStatus: http.StatusOK,
+			wantResult: "21.6",
+		},
+		{
+			name:       "renders against a plain payload",
+			body:       RenderTemplateRequest{Template: "{{ iif(value == 'ON', 'on', 'off') }}", Payload: "ON"},
+			wantStatus: http.StatusOK,
+			wantResult: "on",
+		},
+		{
+			name:       "syntax error",
+			body:       RenderTemplateRequest{Template: "ok\n{{ value | }}"},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantError:  "line 2: expected name, got '}}'",
+			wantLine:   2,
+		},
+		{
+			name:       "render error",
+			body:       RenderTemplateRequest{Template: "{{ value | float }}", Payload: "unknown"},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantError:  "float got invalid input 'unknown' but no default was specified",
+		},
+		{
+			name:       "invalid body",
+			body:       "not an object",
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid request body",
+		},
+	}
+
+	handler := NewTemplateHandler(logr.Discard())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := executeRequest(handler.Render, http.MethodPost, "/api/v1/templates/render", tt.body, nil)
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rr.Code, rr.Body.String())
+			}
+
+			var response struct {
+				Result string `json:"result"`
+				Error  string `json:"error"`
+				Line   int    `json:"line"`
+			}
+			if err := parseJSONResponse(rr, &response); err != nil {
+				t.Fatalf("failed to parse response: %v", err)
+			}
+			if response.Result != tt.wantResult || response.Error != tt.wantError || response.Line != tt.wantLine {
+				t.Errorf("got %+v, want result %q, error %q, line %d", response, tt.wantResult, tt.wantError, tt.wantLine)
+			}
+		})
+	}
+}
@@ -70,6 +70,7 @@ func newTestEntityHandler(objects ...runtime.Object) *EntityHandler {
 			{Group: "mqtt.home-assistant.io", Version: "v1alpha1", Resource: "mqttdevicetrackers"}:     "MQTTDeviceTrackerList",
 			{Group: "mqtt.home-assistant.io", Version: "v1alpha1", Resource: "mqtttags"}:               "MQTTTagList",
 			{Group: "mqtt.home-assistant.io", Version: "v1alpha1", Resource: "mqttdevicetriggers"}:     "MQTTDeviceTriggerList",
+			{Group: "mqtt.home-assistant.io", Version: "v1alpha1", Resource: "mqttentities"}:           "MQTTEntityList",
 			{Group: "mqtt.home-assistant.io", Version: "v1alpha1", Resource: "mqttdevices"}:            "MQTTDeviceList",
 		},
 		objects...,
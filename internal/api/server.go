@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/spontus/hass-crds/internal/api/handlers"
+	"github.com/spontus/hass-crds/internal/mqtt"
 )
 
 //go:embed static/*
@@ -43,6 +44,16 @@ type Server struct {
 	restConfig    *rest.Config
 	log           logr.Logger
 	server        *http.Server
+	health        *handlers.HealthHandler
+	gcReportName  string
+	mqttClient    mqtt.Client
+	export        *exportConfig
+}
+
+// exportConfig identifies the controller whose payloads the export reproduces.
+type exportConfig struct {
+	clusterName string
+	instanceID  string
 }
 
 func NewServer(addr string, client client.Client, restConfig *rest.Config, log logr.Logger) (*Server, error) {
@@ -57,31 +68,114 @@ func NewServer(addr string, client client.Client, restConfig *rest.Config, log l
 		dynamicClient: dynamicClient,
 		restConfig:    restConfig,
 		log:           log.WithName("api-server"),
+		health:        handlers.NewHealthHandler(log.WithName("api-server")),
 	}, nil
 }
 
+// AddHealthCheck registers a named check reported by /api/v1/health.
+// It must be called before Start.
+func (s *Server) AddHealthCheck(name string, check handlers.HealthCheck) {
+	s.health.AddCheck(name, check)
+}
+
+// EnableGarbageCollection serves the report of the MQTTGarbageCollection
+// resource called name and accepts on-demand runs. It must be called before Start.
+func (s *Server) EnableGarbageCollection(name string) {
+	s.gcReportName = name
+}
+
+// EnableImport serves the import of discovery configs published by other
+// tools, scanning the broker through mqttClient. It must be called before Start.
+func (s *Server) EnableImport(mqttClient mqtt.Client) {
+	s.mqttClient = mqttClient
+}
+
+// EnableExport serves the resources as Home Assistant `mqtt:` YAML, built like
+// the payloads of the controller with the given cluster name and instance ID.
+// It must be called before Start.
+func (s *Server) EnableExport(clusterName, instanceID string) {
+	s.export = &exportConfig{clusterName: clusterName, instanceID: instanceID}
+}
+
 func (s *Server) Start(ctx context.Context) error {
 	r := chi.NewRouter()
 
-	r.Use(middleware.Logger)
-	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(30 * time.Second))
+	// Registered outside the logging group so probes do not flood the logs
+	r.Get("/healthz", s.health.Live)
+
+	var routesErr error
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.Logger)
+		r.Use(middleware.Recoverer)
+		r.Use(middleware.Timeout(30 * time.Second))
+
+		routesErr = s.routes(r)
+	})
+	if routesErr != nil {
+		return routesErr
+	}
 
+	s.server = &http.Server{
+		Addr:    s.addr,
+		Handler: r,
+	}
+
+	s.log.Info("starting API server", "addr", s.addr)
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.server.Shutdown(shutdownCtx)
+	}()
+
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
+
+// routes registers the API and UI routes.
+func (s *Server) routes(r chi.Router) error {
 	entityHandler := handlers.NewEntityHandler(s.dynamicClient, s.restConfig, s.log)
 	schemaHandler := handlers.NewSchemaHandler(s.restConfig, s.log)
 	namespaceHandler := handlers.NewNamespaceHandler(s.client, s.log)
+	templateHandler := handlers.NewTemplateHandler(s.log)
 
 	r.Route("/api/v1", func(r chi.Router) {
+		r.Get("/health", s.health.Health)
+
 		r.Get("/entity-types", schemaHandler.ListEntityTypes)
 		r.Get("/entity-types/{kind}/schema", schemaHandler.GetSchema)
+		r.Get("/entity-types/{kind}/discovery-schema", schemaHandler.GetDiscoverySchema)
 
 		r.Get("/namespaces", namespaceHandler.List)
 
+		r.Post("/templates/render", templateHandler.Render)
+
 		r.Get("/entities", entityHandler.List)
 		r.Get("/entities/{kind}/{namespace}/{name}", entityHandler.Get)
 		r.Post("/entities/{kind}/{namespace}", entityHandler.Create)
 		r.Put("/entities/{kind}/{namespace}/{name}", entityHandler.Update)
 		r.Delete("/entities/{kind}/{namespace}/{name}", entityHandler.Delete)
+
+		if s.gcReportName != "" {
+			gcHandler := handlers.NewGarbageCollectionHandler(s.client, s.gcReportName, s.log)
+			r.Get("/gc", gcHandler.Get)
+			r.Post("/gc/run", gcHandler.Run)
+		}
+
+		if s.mqttClient != nil {
+			importHandler := handlers.NewImportHandler(s.client, s.mqttClient, s.log)
+			r.Get("/import", importHandler.List)
+			r.Post("/import/adopt", importHandler.Adopt)
+		}
+
+		if s.export != nil {
+			exportHandler := handlers.NewExportHandler(s.client, s.export.clusterName, s.export.instanceID, s.log)
+			r.Get("/export", exportHandler.Export)
+		}
 	})
 
 	staticFS, err := fs.Sub(staticFiles, "static")
@@ -102,23 +196,5 @@ func (s *Server) Start(ctx context.Context) error {
 		fileServer.ServeHTTP(w, r)
 	})
 
-	s.server = &http.Server{
-		Addr:    s.addr,
-		Handler: r,
-	}
-
-	s.log.Info("starting API server", "addr", s.addr)
-
-	go func() {
-		<-ctx.Done()
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		_ = s.server.Shutdown(shutdownCtx)
-	}()
-
-	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		return err
-	}
-
 	return nil
 }
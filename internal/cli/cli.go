@@ -0,0 +1,173 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cli implements the hass-crds subcommands, which run once and exit
+// instead of starting the controller manager.
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/mqtt"
+)
+
+// connectTimeout bounds the initial broker connection.
+const connectTimeout = 30 * time.Second
+
+// command is a subcommand. run gets the arguments after the subcommand name.
+type command struct {
+	summary string
+	run     func(ctx context.Context, args []string, stdout, stderr io.Writer) error
+}
+
+var commands = map[string]command{
+	"diff":    {"Compare the payloads of manifests with the configs on the broker", runDiff},
+	"export":  {"Print resources as Home Assistant mqtt: YAML configuration", runExport},
+	"import":  {"Convert discovery configs or mqtt: YAML configuration into resources", runImport},
+	"migrate": {"Rewrite resources in the storage version of their CRD", runMigrate},
+	"render":  {"Print the discovery topics and payloads of manifests", runRender},
+}
+
+// exitError ends a command with code without printing an error.
+type exitError struct {
+	code int
+}
+
+func (e exitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+// IsPlugin reports whether the binary was invoked as a kubectl plugin, i.e.
+// through a kubectl-hass_crds link, so its arguments are always subcommands.
+func IsPlugin(arg0 string) bool {
+	return strings.HasPrefix(filepath.Base(arg0), "kubectl-")
+}
+
+// IsCommand reports whether name is a subcommand.
+func IsCommand(name string) bool {
+	_, ok := commands[name]
+	return ok || name == "help"
+}
+
+// Run runs the subcommand named by args[0] and returns the process exit code:
+// 2 on errors, following kubectl diff, which uses 1 for differences found.
+func Run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" {
+		usage(stderr)
+		return 0
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		usage(stderr)
+		return 2
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := cmd.run(ctx, args[1:], stdout, stderr); err != nil {
+		var exit exitError
+		switch {
+		case errors.Is(err, flag.ErrHelp):
+			return 0
+		case errors.As(err, &exit):
+			return exit.code
+		}
+		fmt.Fprintf(stderr, "hass-crds %s: %v\n", args[0], err)
+		return 2
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: hass-crds <command> [flags]")
+	fmt.Fprintln(w, "\nWithout a command the controller manager is started.\n\nCommands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(w, "\nRun 'hass-crds <command> -h' for the flags of a command.")
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (s *stringList) String() string { return strings.Join(*s, ",") }
+
+func (s *stringList) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+// connectMQTT connects to the broker configured by the MQTT_* environment
+// variables. The client ID is made unique so a running controller using the
+// same configuration is not disconnected.
+func connectMQTT(ctx context.Context, name string) (mqtt.Client, error) {
+	config, err := mqtt.NewConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	config.ClientID = fmt.Sprintf("%s-%s-%d", config.ClientID, name, os.Getpid())
+
+	mqttClient := mqtt.New(config, logr.Discard())
+
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+	if err := mqttClient.Connect(ctx); err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", config.BrokerURL(), err)
+	}
+	return mqttClient, nil
+}
+
+// newKubeClient creates a client for the cluster of the current kubeconfig.
+func newKubeClient() (client.Client, error) {
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(mqttv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+
+	return client.New(config, client.Options{Scheme: scheme})
+}
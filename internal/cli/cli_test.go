@@ -0,0 +1,175 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+const testManifests = `apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: MQTTDevice
+metadata:
+  name: garage
+  namespace: home
+spec:
+  name: Garage
+  identifiers: ["garage-1"]
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+---
+apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: MQTTSwitch
+metadata:
+  name: door
+  namespace: home
+spec:
+  commandTopic: garage/door/set
+  deviceRef:
+    name: garage
+`
+
+func TestRenderManifests(t *testing.T) {
+	opts := &renderOptions{namespace: "default", clusterName: "c1"}
+	rendered, err := renderManifests(context.Background(), nil, strings.NewReader(testManifests), opts)
+	if err != nil {
+		t.Fatalf("renderManifests() error: %v", err)
+	}
+	if len(rendered) != 1 {
+		t.Fatalf("rendered %d messages, want 1", len(rendered))
+	}
+
+	r := rendered[0]
+	if r.Topic != "homeassistant/switch/c1_home/door/config" {
+		t.Errorf("Topic = %q", r.Topic)
+	}
+	payload := string(r.Payload)
+	for _, want := range []string{`"name":"Garage"`, `"name":"hass-crds/c1"`, `"unique_id":"c1_home-door"`} {
+		if !strings.Contains(payload, want) {
+			t.Errorf("payload %s does not contain %s", payload, want)
+		}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	got := diffLines(
+		[]string{"{", `  "a": 1,`, `  "b": 2`, "}"},
+		[]string{"{", `  "a": 1,`, `  "b": 3,`, `  "c": 4`, "}"},
+	)
+	want := []string{" {", `   "a": 1,`, `-  "b": 2`, `+  "b": 3,`, `+  "c": 4`, " }"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diffLines() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := Run([]string{"render", "-output", "yaml"}, &stdout, &stderr); code != 2 {
+		t.Errorf("Run() with bad output format = %d, want 2", code)
+	}
+	if code := Run([]string{"bogus"}, &stdout, &stderr); code != 2 {
+		t.Errorf("Run() with unknown command = %d, want 2", code)
+	}
+	if !IsPlugin("/usr/local/bin/kubectl-hass_crds") || IsPlugin("/manager") {
+		t.Error("IsPlugin() did not recognize the kubectl plugin name")
+	}
+}
+
+func TestMigrateStorage(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+
+	crd := func(plural string, stored ...string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: plural + ".mqtt.home-assistant.io"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1alpha1", Served: true},
+					{Name: "v1beta1", Served: true, Storage: true},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: stored},
+		}
+	}
+	button := &mqttv1alpha1.MQTTButton{
+		ObjectMeta: metav1.ObjectMeta{Name: "restart", Namespace: "default"},
+		Spec:       mqttv1alpha1.MQTTButtonSpec{CommandTopic: "server/restart"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(crd("mqttbuttons", "v1alpha1", "v1beta1"), crd("mqttswitches", "v1beta1"), button).
+		WithStatusSubresource(&apiextensionsv1.CustomResourceDefinition{}).
+		Build()
+	ctx := context.Background()
+
+	var out bytes.Buffer
+	if err := migrateStorage(ctx, c, &out, true); err != nil {
+		t.Fatalf("migrateStorage() dry run error: %v", err)
+	}
+	if !strings.Contains(out.String(), "MQTTButton: would rewrite 1 resources as v1beta1") {
+		t.Errorf("dry run output:\n%s", out.String())
+	}
+	got := &apiextensionsv1.CustomResourceDefinition{}
+	_ = c.Get(ctx, client.ObjectKey{Name: "mqttbuttons.mqtt.home-assistant.io"}, got)
+	if len(got.Status.StoredVersions) != 2 {
+		t.Errorf("dry run changed stored versions to %v", got.Status.StoredVersions)
+	}
+
+	before := &mqttv1alpha1.MQTTButton{}
+	_ = c.Get(ctx, client.ObjectKeyFromObject(button), before)
+
+	out.Reset()
+	if err := migrateStorage(ctx, c, &out, false); err != nil {
+		t.Fatalf("migrateStorage() error: %v", err)
+	}
+	for _, want := range []string{
+		"MQTTButton: rewrote 1 resources as v1beta1",
+		"MQTTSwitch: stored as v1beta1",
+		"MQTTLight: CRD not installed, skipped",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, out.String())
+		}
+	}
+	_ = c.Get(ctx, client.ObjectKey{Name: "mqttbuttons.mqtt.home-assistant.io"}, got)
+	if !slices.Equal(got.Status.StoredVersions, []string{"v1beta1"}) {
+		t.Errorf("stored versions = %v, want [v1beta1]", got.Status.StoredVersions)
+	}
+	rewritten := &mqttv1alpha1.MQTTButton{}
+	_ = c.Get(ctx, client.ObjectKeyFromObject(button), rewritten)
+	if rewritten.ResourceVersion == before.ResourceVersion {
+		t.Error("button was not written back")
+	}
+	if rewritten.Spec.CommandTopic != "server/restart" {
+		t.Errorf("commandTopic after rewrite = %q, want server/restart", rewritten.Spec.CommandTopic)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spontus/hass-crds/internal/gc"
+	"github.com/spontus/hass-crds/internal/importer"
+)
+
+// runDiff compares the discovery messages rendered from the given manifests
+// with the retained configs on the broker. Like kubectl diff it exits with 1
+// when there are differences.
+func runDiff(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	opts := addRenderFlags(fs)
+	silence := fs.Duration("silence-timeout", importer.DefaultSilenceTimeout, "How long to wait for further retained messages")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: hass-crds diff [flags] [file or directory ...]")
+		fmt.Fprintln(stderr, "\nReads manifests from stdin when no file is given. The broker is configured")
+		fmt.Fprintln(stderr, "by the MQTT_* environment variables. Exits with 1 when there are differences.\n\nFlags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rendered, err := renderManifests(ctx, fs.Args(), os.Stdin, opts)
+	if err != nil {
+		return err
+	}
+
+	mqttClient, err := connectMQTT(ctx, "diff")
+	if err != nil {
+		return err
+	}
+	defer mqttClient.Disconnect()
+
+	entities, err := gc.ScanDiscovery(ctx, mqttClient, *silence)
+	if err != nil {
+		return err
+	}
+	retained := make(map[string][]byte, len(entities))
+	for _, e := range entities {
+		if len(e.Payload) > 0 {
+			retained[e.Topic] = e.Payload
+		}
+	}
+
+	changed := 0
+	for _, r := range rendered {
+		want := prettyJSON(r.Payload)
+		data, ok := retained[r.Topic]
+		got := prettyJSON(data)
+		if ok && got == want {
+			continue
+		}
+
+		changed++
+		from := "broker " + r.Topic
+		var old []string
+		if ok {
+			old = strings.Split(got, "\n")
+		} else {
+			from = "/dev/null"
+		}
+		fmt.Fprintf(stdout, "--- %s\n+++ %s %s/%s %s\n", from, r.Kind, r.Namespace, r.Name, r.Topic)
+		for _, line := range diffLines(old, strings.Split(want, "\n")) {
+			fmt.Fprintln(stdout, line)
+		}
+	}
+
+	fmt.Fprintf(stderr, "%d of %d discovery configs differ from the broker\n", changed, len(rendered))
+	if changed > 0 {
+		return exitError{code: 1}
+	}
+	return nil
+}
+
+// diffLines returns the lines of a and b prefixed with " ", "-" or "+" to
+// turn a into b, based on their longest common subsequence.
+func diffLines(a, b []string) []string {
+	// lcs[i][j] is the length of the LCS of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, " "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, "-"+a[i])
+			i++
+		default:
+			out = append(out, "+"+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, "-"+a[i])
+	}
+	for ; j < len(b); j++ {
+		out = append(out, "+"+b[j])
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/spontus/hass-crds/internal/exporter"
+	"github.com/spontus/hass-crds/internal/manifest"
+)
+
+// runExport prints resources as Home Assistant `mqtt:` YAML configuration.
+// Resources are read from the given manifests, or listed from the current
+// kubeconfig's cluster when no file is given.
+func runExport(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	opts := addRenderFlags(fs)
+	allNamespaces := fs.Bool("all-namespaces", false, "Export the resources of all namespaces of the cluster")
+	selectorFlag := fs.String("selector", "", "Only export resources matching this label selector")
+	output := fs.String("output", "yaml", "Output format: yaml or json")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: hass-crds export [flags] [file or directory ...]")
+		fmt.Fprintln(stderr, "\nLists the resources of the cluster when no file is given; use - for stdin.\n\nFlags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "yaml" && *output != "json" {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+	selector, err := labels.Parse(*selectorFlag)
+	if err != nil {
+		return fmt.Errorf("invalid selector: %w", err)
+	}
+
+	base, err := newBase(nil, opts)
+	if err != nil {
+		return err
+	}
+
+	var objs []client.Object
+	if fs.NArg() > 0 {
+		m, err := manifest.Load(fs.Args(), os.Stdin, opts.namespace)
+		if err != nil {
+			return err
+		}
+		base.Client = fake.NewClientBuilder().WithScheme(manifest.Scheme).WithObjects(m.Devices...).WithObjects(m.Defaults...).Build()
+		for _, obj := range m.Entities {
+			if selector.Matches(labels.Set(obj.GetLabels())) {
+				objs = append(objs, obj)
+			}
+		}
+	} else {
+		k8sClient, err := newKubeClient()
+		if err != nil {
+			return err
+		}
+		namespace := opts.namespace
+		if *allNamespaces {
+			namespace = ""
+		}
+		base.Client = k8sClient
+		if objs, err = exporter.List(ctx, k8sClient, namespace, selector); err != nil {
+			return err
+		}
+	}
+
+	result, err := exporter.Export(ctx, base, objs)
+	if err != nil {
+		return err
+	}
+
+	if *output == "json" {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+	for _, s := range result.Skipped {
+		fmt.Fprintf(stderr, "Skipped %s %s/%s: %s\n", s.Kind, s.Namespace, s.Name, s.Reason)
+	}
+	return result.WriteYAML(stdout)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spontus/hass-crds/internal/importer"
+	"github.com/spontus/hass-crds/internal/mqtt"
+)
+
+// runImport converts discovery configs published by other tools on the
+// broker, or the MQTT entities of Home Assistant YAML configuration with
+// -from-yaml, into resources and prints them, or adopts them with -adopt.
+func runImport(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	namespace := fs.String("namespace", "default", "Namespace of the generated resources")
+	silence := fs.Duration("silence-timeout", importer.DefaultSilenceTimeout, "How long to wait for further retained messages")
+	adopt := fs.Bool("adopt", false, "Create the resources in the current kubeconfig's cluster and clear the original configs")
+	fromYAML := fs.String("from-yaml", "", "Import the mqtt: entities of this Home Assistant YAML file (- for stdin) instead of scanning the broker")
+	var topics stringList
+	fs.Var(&topics, "topic", "Only import this discovery topic (repeatable)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: hass-crds import [flags]")
+		fmt.Fprintln(stderr, "\nThe broker is configured by the MQTT_* environment variables.\n\nFlags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *fromYAML != "" && len(topics) > 0 {
+		return fmt.Errorf("-topic cannot be combined with -from-yaml")
+	}
+
+	var (
+		result     *importer.Result
+		mqttClient mqtt.Client
+		err        error
+	)
+	if *fromYAML != "" {
+		var data []byte
+		if *fromYAML == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(*fromYAML)
+		}
+		if err != nil {
+			return err
+		}
+		if result, err = importer.ParseConfig(data, *namespace); err != nil {
+			return fmt.Errorf("%s: %w", *fromYAML, err)
+		}
+	} else {
+		client, err := connectMQTT(ctx, "import")
+		if err != nil {
+			return err
+		}
+		defer client.Disconnect()
+		mqttClient = client
+
+		if result, err = importer.Discover(ctx, mqttClient, *namespace, *silence); err != nil {
+			return err
+		}
+	}
+	for _, s := range result.Skipped {
+		fmt.Fprintf(stderr, "Skipped %s: %s\n", source(s.Topic, s.Source), s.Reason)
+	}
+
+	candidates := result.Candidates
+	if len(topics) > 0 {
+		candidates = selectTopics(candidates, topics, stderr)
+	}
+
+	if !*adopt {
+		return importer.WriteYAML(stdout, candidates)
+	}
+
+	k8sClient, err := newKubeClient()
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, c := range candidates {
+		if err := importer.Adopt(ctx, k8sClient, mqttClient, c); err != nil {
+			fmt.Fprintf(stderr, "Failed to adopt %s: %v\n", source(c.Topic, c.Source), err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(stdout, "Adopted %s as %s %s/%s\n", source(c.Topic, c.Source), c.Kind, c.Namespace, c.Name)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d configs not adopted", failed, len(candidates))
+	}
+	return nil
+}
+
+// source returns the discovery topic of an imported config, or its location
+// in the YAML configuration.
+func source(topic, yamlPath string) string {
+	if topic != "" {
+		return topic
+	}
+	return yamlPath
+}
+
+// selectTopics returns the candidates for topics, reporting topics without one.
+func selectTopics(candidates []*importer.Candidate, topics []string, stderr io.Writer) []*importer.Candidate {
+	byTopic := make(map[string]*importer.Candidate, len(candidates))
+	for _, c := range candidates {
+		byTopic[c.Topic] = c
+	}
+
+	var selected []*importer.Candidate
+	for _, t := range topics {
+		if c, ok := byTopic[t]; ok {
+			selected = append(selected, c)
+		} else {
+			fmt.Fprintf(stderr, "No importable discovery config on %s\n", t)
+		}
+	}
+	return selected
+}
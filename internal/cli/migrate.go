@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// migratePageSize is the number of resources listed per request.
+const migratePageSize = 500
+
+// runMigrate moves the resources of every CRD to its storage version, so the
+// versions it replaces can be removed from the CRDs.
+func runMigrate(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	dryRun := fs.Bool("dry-run", false, "Report what would be migrated without writing")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: hass-crds migrate [flags]")
+		fmt.Fprintln(stderr, "\nRewrites every resource in the cluster of the current kubeconfig so the API")
+		fmt.Fprintln(stderr, "server stores it in the storage version of its CRD, then removes the other")
+		fmt.Fprintln(stderr, "versions from the status.storedVersions of the CRD. The controller must be")
+		fmt.Fprintln(stderr, "running to serve the conversion webhook.\n\nFlags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	c, err := newKubeClient()
+	if err != nil {
+		return err
+	}
+	return migrateStorage(ctx, c, stdout, *dryRun)
+}
+
+// migrateStorage migrates the resources of every kind in the registry.
+func migrateStorage(ctx context.Context, c client.Client, w io.Writer, dryRun bool) error {
+	for _, kind := range registry.All() {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		name := kind.Plural + "." + mqttv1alpha1.GroupVersion.Group
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, crd); err != nil {
+			if apierrors.IsNotFound(err) {
+				fmt.Fprintf(w, "%s: CRD not installed, skipped\n", kind.Kind)
+				continue
+			}
+			return fmt.Errorf("getting CRD %s: %w", name, err)
+		}
+
+		storage := storageVersion(crd)
+		if slices.Equal(crd.Status.StoredVersions, []string{storage}) {
+			fmt.Fprintf(w, "%s: stored as %s\n", kind.Kind, storage)
+			continue
+		}
+
+		count, err := rewriteAll(ctx, c, kind, dryRun)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			fmt.Fprintf(w, "%s: would rewrite %d resources as %s, stored versions %v\n", kind.Kind, count, storage, crd.Status.StoredVersions)
+			continue
+		}
+
+		crd.Status.StoredVersions = []string{storage}
+		if err := c.Status().Update(ctx, crd); err != nil {
+			return fmt.Errorf("updating stored versions of CRD %s: %w", name, err)
+		}
+		fmt.Fprintf(w, "%s: rewrote %d resources as %s\n", kind.Kind, count, storage)
+	}
+	return nil
+}
+
+// storageVersion returns the version crd stores resources in.
+func storageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
+
+// rewriteAll writes every resource of kind back unchanged, which makes the
+// API server store it in the storage version, and returns their number.
+// Resources are written as v1alpha1, whose schema every stored resource
+// passed, as v1beta1 validates fields v1alpha1 did not. Resources changed or
+// deleted meanwhile were written by someone else and need no rewrite.
+func rewriteAll(ctx context.Context, c client.Client, kind *registry.Kind, dryRun bool) (int, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(mqttv1alpha1.GroupVersion.WithKind(kind.Kind + "List"))
+
+	count := 0
+	opts := []client.ListOption{client.Limit(migratePageSize)}
+	for {
+		if err := c.List(ctx, list, opts...); err != nil {
+			return count, fmt.Errorf("listing %s: %w", kind.Plural, err)
+		}
+		for i := range list.Items {
+			if !dryRun {
+				err := c.Update(ctx, &list.Items[i])
+				if err != nil && !apierrors.IsConflict(err) && !apierrors.IsNotFound(err) {
+					return count, fmt.Errorf("rewriting %s %s/%s: %w", kind.Kind, list.Items[i].GetNamespace(), list.Items[i].GetName(), err)
+				}
+			}
+			count++
+		}
+		if list.GetContinue() == "" {
+			return count, nil
+		}
+		opts = []client.ListOption{client.Limit(migratePageSize), client.Continue(list.GetContinue())}
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/spontus/hass-crds/internal/controller"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/manifest"
+)
+
+// renderOptions are the flags shared by render and diff.
+type renderOptions struct {
+	namespace   string
+	clusterName string
+	instanceID  string
+	haVersion   string
+}
+
+// addRenderFlags registers the render flags on fs. The cluster name and
+// instance ID default to the controller's environment variables so the
+// output matches what that controller publishes.
+func addRenderFlags(fs *flag.FlagSet) *renderOptions {
+	opts := &renderOptions{}
+	fs.StringVar(&opts.namespace, "namespace", "default", "Namespace of resources that do not set one")
+	fs.StringVar(&opts.clusterName, "cluster-name", os.Getenv("CLUSTER_NAME"), "Cluster name folded into unique IDs and node IDs (env CLUSTER_NAME)")
+	fs.StringVar(&opts.instanceID, "instance-id", os.Getenv("INSTANCE_ID"), "Instance ID carried in the origin name; defaults to the cluster name (env INSTANCE_ID)")
+	fs.StringVar(&opts.haVersion, "ha-version", os.Getenv("TARGET_HA_VERSION"), "Oldest Home Assistant release to build payloads for, e.g. 2025.10 (env TARGET_HA_VERSION)")
+	return opts
+}
+
+// newBase returns a reconciler that builds payloads like the controller
+// configured with opts, resolving deviceRef through c.
+func newBase(c client.Client, opts *renderOptions) (*controller.BaseReconciler, error) {
+	targetVersion, err := hass.ParseVersion(opts.haVersion)
+	if err != nil {
+		return nil, err
+	}
+	instanceID := opts.instanceID
+	if instanceID == "" {
+		instanceID = opts.clusterName
+	}
+	return &controller.BaseReconciler{
+		Client:        c,
+		Log:           logr.Discard(),
+		InstanceID:    instanceID,
+		ClusterName:   opts.clusterName,
+		TargetVersion: targetVersion,
+	}, nil
+}
+
+// renderManifests builds the discovery messages of the entities in paths.
+// deviceRef is resolved against the MQTTDevice resources of the same input,
+// and the MQTTDefaults and ClusterMQTTDefaults of the input are merged in.
+func renderManifests(ctx context.Context, paths []string, stdin io.Reader, opts *renderOptions) ([]*controller.Rendered, error) {
+	m, err := manifest.Load(paths, stdin, opts.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := newBase(fake.NewClientBuilder().WithScheme(manifest.Scheme).WithObjects(m.Devices...).WithObjects(m.Defaults...).Build(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := make([]*controller.Rendered, 0, len(m.Entities))
+	for _, obj := range m.Entities {
+		r, err := base.Render(ctx, obj)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s/%s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetNamespace(), obj.GetName(), err)
+		}
+		rendered = append(rendered, r)
+	}
+	return rendered, nil
+}
+
+// runRender prints the discovery topic and payload of every entity in the
+// given manifests without a cluster or broker.
+func runRender(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("render", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	opts := addRenderFlags(fs)
+	output := fs.String("output", "text", "Output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: hass-crds render [flags] [file or directory ...]")
+		fmt.Fprintln(stderr, "\nReads manifests from stdin when no file is given.\n\nFlags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "text" && *output != "json" {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+
+	rendered, err := renderManifests(ctx, fs.Args(), os.Stdin, opts)
+	if err != nil {
+		return err
+	}
+
+	if *output == "json" {
+		type message struct {
+			Kind      string          `json:"kind"`
+			Namespace string          `json:"namespace"`
+			Name      string          `json:"name"`
+			Topic     string          `json:"topic"`
+			QoS       byte            `json:"qos"`
+			Payload   json.RawMessage `json:"payload"`
+		}
+		messages := make([]message, 0, len(rendered))
+		for _, r := range rendered {
+			messages = append(messages, message{r.Kind, r.Namespace, r.Name, r.Topic, r.QoS, r.Payload})
+		}
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(messages)
+	}
+
+	for _, r := range rendered {
+		fmt.Fprintf(stdout, "# %s %s/%s\n%s\n%s\n\n", r.Kind, r.Namespace, r.Name, r.Topic, prettyJSON(r.Payload))
+	}
+	return nil
+}
+
+// prettyJSON indents a JSON payload with sorted keys, or returns it as is if
+// it is not valid JSON.
+func prettyJSON(data []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return string(data)
+	}
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return string(data)
+	}
+	return string(out)
+}
@@ -18,7 +18,9 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -28,8 +30,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/metrics"
 	"github.com/spontus/hass-crds/internal/mqtt"
 	"github.com/spontus/hass-crds/internal/payload"
+	"github.com/spontus/hass-crds/internal/registry"
 	"github.com/spontus/hass-crds/internal/topic"
 )
 
@@ -42,6 +47,15 @@ const (
 
 	// DefaultRetain indicates whether discovery messages should be retained.
 	DefaultRetain = true
+
+	// QueuedRequeueInterval is how soon an entity whose discovery message is
+	// still waiting in the publish outbox is reconciled again.
+	QueuedRequeueInterval = 15 * time.Second
+
+	// MQTT v5 user properties attached to every discovery publish.
+	UserPropertyUID      = "cr-uid"
+	UserPropertyKind     = "cr-kind"
+	UserPropertyResource = "cr-resource"
 )
 
 // BaseReconciler contains common reconciliation logic for all MQTT entity controllers.
@@ -49,47 +63,110 @@ type BaseReconciler struct {
 	Client     client.Client
 	Log        logr.Logger
 	MQTTClient mqtt.Client
+	// InstanceID identifies this controller instance in the origin block.
+	InstanceID string
+	// ClusterName is folded into unique IDs and discovery node IDs.
+	ClusterName string
+	// Drift, if set, watches published configs and repairs them when they drift.
+	Drift *DriftDetector
+	// TargetVersion is the oldest Home Assistant release the payloads are
+	// built for. Deprecated keys are rewritten or left out for it; the zero
+	// Version publishes them as the spec sets them.
+	TargetVersion hass.Version
 }
 
-// EntityObject is an interface for all MQTT entity types.
-type EntityObject interface {
-	client.Object
-	GetCommonSpec() *mqttv1alpha1.CommonSpec
-	GetCommonStatus() *mqttv1alpha1.CommonStatus
-	SetCommonStatus(status mqttv1alpha1.CommonStatus)
-	// GetObject returns the underlying client.Object for status updates.
-	// Wrapper types must implement this to return the actual CRD type
-	// that is registered with the scheme.
-	GetObject() client.Object
+// publishQueue is implemented by MQTT clients that may defer a publish, such as mqtt.Outbox.
+type publishQueue interface {
+	Pending(topic string) bool
 }
 
-// PayloadBuilder is a function that builds the discovery payload for an entity.
-type PayloadBuilder func(obj EntityObject, uniqueID string) (*payload.Builder, error)
-
 // PublishDiscovery publishes the MQTT discovery message for an entity.
-func (r *BaseReconciler) PublishDiscovery(ctx context.Context, obj EntityObject, kind string, buildPayload PayloadBuilder) error {
+func (r *BaseReconciler) PublishDiscovery(ctx context.Context, obj registry.Entity, k *registry.Kind) error {
+	merged, defaults, err := r.mergeDefaults(ctx, obj, k)
+	if err != nil {
+		return fmt.Errorf("merging defaults: %w", err)
+	}
+	return r.publishDiscovery(ctx, merged, defaults, k)
+}
+
+// publishDiscovery publishes the discovery message for an entity the
+// defaults that select it are already merged into.
+func (r *BaseReconciler) publishDiscovery(ctx context.Context, obj registry.Entity, defaults *appliedDefaults, k *registry.Kind) error {
+	discoveryTopic, jsonPayload, qos, err := r.buildDiscovery(ctx, obj, defaults, k)
+	if err != nil {
+		return err
+	}
+	kind := k.Kind
+
+	// A generic entity moves to another topic when its component changes
+	if old := obj.GetCommonStatus().DiscoveryTopic; k.IsGeneric() && old != "" && old != discoveryTopic {
+		r.Drift.Forget(old)
+		if err := r.publish(ctx, obj, kind, old, []byte{}, DefaultQoS); err != nil {
+			return err
+		}
+		r.Log.Info("Removed discovery message of previous component", "topic", old, "kind", kind, "name", obj.GetName())
+	}
+
+	// Publish to MQTT, expecting the config back before it can be echoed
+	r.Drift.Expect(obj, kind, discoveryTopic, jsonPayload, qos)
+	if err := r.publish(ctx, obj, kind, discoveryTopic, jsonPayload, qos); err != nil {
+		return err
+	}
+
+	r.Log.Info("Published discovery message", "topic", discoveryTopic, "kind", kind, "name", obj.GetName())
+	return nil
+}
+
+// BuildDiscovery builds the discovery topic, payload and QoS for an entity
+// without publishing them.
+func (r *BaseReconciler) BuildDiscovery(ctx context.Context, obj registry.Entity, kind *registry.Kind) (string, []byte, byte, error) {
+	// Merge the defaults that select the entity into a copy of it
+	merged, defaults, err := r.mergeDefaults(ctx, obj, kind)
+	if err != nil {
+		return "", nil, 0, fmt.Errorf("merging defaults: %w", err)
+	}
+	return r.buildDiscovery(ctx, merged, defaults, kind)
+}
+
+// buildDiscovery builds the discovery message for an entity the defaults
+// that select it are already merged into.
+func (r *BaseReconciler) buildDiscovery(ctx context.Context, obj registry.Entity, defaults *appliedDefaults, kind *registry.Kind) (string, []byte, byte, error) {
 	namespace := obj.GetNamespace()
 	name := obj.GetName()
 
 	// Get the common spec
 	spec := obj.GetCommonSpec()
 
+	// Generate discovery topic
+	discoveryTopic := r.discoveryTopic(obj, kind)
+	if discoveryTopic == "" {
+		return "", nil, 0, &hass.ValidationError{Component: kind.Kind, Problems: []string{"component is required"}}
+	}
+
 	// Generate unique ID
-	uniqueID := topic.UniqueIDWithOverride(spec.UniqueId, namespace, name)
+	uniqueID := topic.UniqueIDWithOverride(spec.UniqueId, r.ClusterName, namespace, name)
 
 	// Build the payload
-	pb, err := buildPayload(obj, uniqueID)
+	pb, err := kind.Build(obj)
 	if err != nil {
-		return err
+		return "", nil, 0, err
 	}
 
-	// Add unique_id to payload
-	pb.Set("uniqueId", uniqueID)
+	// Add unique_id to payload, unless the component has no entity to identify
+	schema := hass.Lookup(kind.ComponentOf(obj))
+	if schema == nil || schema.Accepts("unique_id") {
+		pb.Set("uniqueId", uniqueID)
+	}
 
 	// Resolve device configuration: inline device block or deviceRef
 	deviceBlock, err := r.resolveDevice(ctx, spec, namespace)
 	if err != nil {
-		return fmt.Errorf("resolving device: %w", err)
+		return "", nil, 0, fmt.Errorf("resolving device: %w", err)
+	}
+	if deviceBlock != nil && deviceBlock.SuggestedArea == "" && defaults.suggestedArea != "" {
+		block := *deviceBlock
+		block.SuggestedArea = defaults.suggestedArea
+		deviceBlock = &block
 	}
 	if deviceBlock != nil {
 		device := payload.DeviceBlockToMap(
@@ -127,16 +204,37 @@ func (r *BaseReconciler) PublishDiscovery(ctx context.Context, obj EntityObject,
 	}
 
 	// Add origin block for garbage collection identification
-	pb.SetOrigin(payload.DefaultOrigin())
+	pb.SetOrigin(payload.DefaultOrigin(r.InstanceID))
 
-	// Build JSON payload
-	jsonPayload, err := pb.Build()
+	// Merge the config of a generic entity, then extraConfig last, overriding
+	// typed fields but not reserved keys
+	config, err := genericConfig(obj, kind)
 	if err != nil {
-		return err
+		return "", nil, 0, err
 	}
+	pb.Merge(config)
+	extra, err := extraConfig(obj, kind)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	pb.Merge(extra)
 
-	// Generate discovery topic
-	discoveryTopic := topic.DiscoveryTopic(kind, namespace, name)
+	// Rewrite keys deprecated in the targeted Home Assistant release
+	data := pb.BuildMap()
+	hass.Upgrade(r.TargetVersion, kind.ComponentOf(obj), data)
+
+	// Refuse payloads Home Assistant would reject
+	if schema != nil {
+		if err := schema.Validate(data, append(keys(config), keys(extra)...)...); err != nil {
+			return "", nil, 0, err
+		}
+	}
+
+	// Build JSON payload
+	jsonPayload, err := json.Marshal(data)
+	if err != nil {
+		return "", nil, 0, err
+	}
 
 	// Determine QoS
 	qos := DefaultQoS
@@ -144,13 +242,49 @@ func (r *BaseReconciler) PublishDiscovery(ctx context.Context, obj EntityObject,
 		qos = byte(*spec.Qos)
 	}
 
-	// Publish to MQTT
-	if err := r.MQTTClient.Publish(ctx, discoveryTopic, jsonPayload, qos, DefaultRetain); err != nil {
-		return err
+	return discoveryTopic, jsonPayload, qos, nil
+}
+
+// publish sends a retained discovery message for obj and records publish metrics.
+func (r *BaseReconciler) publish(ctx context.Context, obj registry.Entity, kind, discoveryTopic string, data []byte, qos byte) error {
+	start := time.Now()
+	err := r.MQTTClient.Publish(withSourceProperties(ctx, obj, kind), discoveryTopic, data, qos, DefaultRetain)
+
+	result := metrics.ResultSuccess
+	switch {
+	case err != nil:
+		result = metrics.ResultError
+	case r.queued(discoveryTopic):
+		result = metrics.ResultQueued
+	}
+	if result != metrics.ResultQueued {
+		metrics.PublishDuration.WithLabelValues(kind).Observe(time.Since(start).Seconds())
 	}
+	metrics.PublishesTotal.WithLabelValues(kind, result).Inc()
 
-	r.Log.Info("Published discovery message", "topic", discoveryTopic, "kind", kind, "name", name)
-	return nil
+	return err
+}
+
+// queued reports whether a discovery message for topic is waiting in the
+// publish outbox.
+func (r *BaseReconciler) queued(topic string) bool {
+	q, ok := r.MQTTClient.(publishQueue)
+	return ok && q.Pending(topic)
+}
+
+// withSourceProperties tags publishes with the source CR as MQTT v5 user
+// properties so broker-side tooling can trace a message back to its resource.
+func withSourceProperties(ctx context.Context, obj registry.Entity, kind string) context.Context {
+	return withSource(ctx, obj.GetUID(), kind, obj.GetNamespace(), obj.GetName())
+}
+
+// withSource is withSourceProperties for a resource identified by its fields.
+func withSource(ctx context.Context, uid types.UID, kind, namespace, name string) context.Context {
+	return mqtt.WithUserProperties(ctx,
+		mqtt.UserProperty{Key: UserPropertyUID, Value: string(uid)},
+		mqtt.UserProperty{Key: UserPropertyKind, Value: kind},
+		mqtt.UserProperty{Key: UserPropertyResource, Value: namespace + "/" + name},
+	)
 }
 
 // resolveDevice returns the DeviceBlock from either inline spec.Device or by
@@ -175,48 +309,190 @@ func (r *BaseReconciler) resolveDevice(ctx context.Context, spec *mqttv1alpha1.C
 }
 
 // HandleDeletion publishes an empty payload to remove the entity from Home Assistant.
-func (r *BaseReconciler) HandleDeletion(ctx context.Context, obj EntityObject, kind string) error {
-	namespace := obj.GetNamespace()
+func (r *BaseReconciler) HandleDeletion(ctx context.Context, obj registry.Entity, k *registry.Kind) error {
 	name := obj.GetName()
+	kind := k.Kind
 
-	// Generate discovery topic
-	discoveryTopic := topic.DiscoveryTopic(kind, namespace, name)
+	// Generate discovery topic. A generic entity is also removed from the
+	// topic it was last published on, in case its component changed since.
+	topics := []string{r.discoveryTopic(obj, k)}
+	if old := obj.GetCommonStatus().DiscoveryTopic; k.IsGeneric() && old != topics[0] {
+		topics = append(topics, old)
+	}
 
 	// Publish empty payload to remove entity
-	if err := r.MQTTClient.Publish(ctx, discoveryTopic, []byte{}, DefaultQoS, DefaultRetain); err != nil {
-		return err
+	for _, discoveryTopic := range topics {
+		if discoveryTopic == "" {
+			continue
+		}
+		r.Drift.Forget(discoveryTopic)
+		if err := r.publish(ctx, obj, kind, discoveryTopic, []byte{}, DefaultQoS); err != nil {
+			return err
+		}
+		r.Log.Info("Published deletion message", "topic", discoveryTopic, "kind", kind, "name", name)
 	}
-
-	r.Log.Info("Published deletion message", "topic", discoveryTopic, "kind", kind, "name", name)
 	return nil
 }
 
-// UpdateStatusPublished updates the status to reflect a successful publish.
-func (r *BaseReconciler) UpdateStatusPublished(ctx context.Context, obj EntityObject, kind string) error {
-	namespace := obj.GetNamespace()
-	name := obj.GetName()
+// discoveryTopic returns the discovery topic of obj, or "" for a generic
+// entity without a component.
+func (r *BaseReconciler) discoveryTopic(obj registry.Entity, kind *registry.Kind) string {
+	if !kind.IsGeneric() {
+		return topic.DiscoveryTopic(r.ClusterName, kind.Kind, obj.GetNamespace(), obj.GetName())
+	}
+	component := kind.ComponentOf(obj)
+	if component == "" {
+		return ""
+	}
+	return topic.ComponentDiscoveryTopic(r.ClusterName, component, obj.GetNamespace(), obj.GetName())
+}
 
+// UpdateStatusPublished updates the status of obj to reflect a successful
+// publish. The spec checks run on merged, the copy of obj with its defaults
+// merged in that was published.
+func (r *BaseReconciler) UpdateStatusPublished(ctx context.Context, obj, merged registry.Entity, kind *registry.Kind) error {
 	status := obj.GetCommonStatus()
-	now := metav1.Now()
-	status.LastPublished = &now
-	status.DiscoveryTopic = topic.DiscoveryTopic(kind, namespace, name)
+	status.DiscoveryTopic = r.discoveryTopic(obj, kind)
 	status.ObservedGeneration = obj.GetGeneration()
 
 	// Update or add Published condition
-	r.SetCondition(status, mqttv1alpha1.ConditionTypePublished, mqttv1alpha1.ConditionTrue, "Success", "Discovery message published")
+	if r.queued(status.DiscoveryTopic) {
+		r.SetCondition(status, mqttv1alpha1.ConditionTypePublished, mqttv1alpha1.ConditionFalse, "Queued", "Discovery message queued until the MQTT broker is reachable")
+	} else {
+		now := metav1.Now()
+		status.LastPublished = &now
+		r.SetCondition(status, mqttv1alpha1.ConditionTypePublished, mqttv1alpha1.ConditionTrue, "Success", "Discovery message published")
+	}
+	r.setMQTTConnectedCondition(status)
+	if hasCondition(status, mqttv1alpha1.ConditionTypeInvalidPayload) {
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeInvalidPayload, mqttv1alpha1.ConditionFalse, "Valid", "Discovery payload is valid")
+	}
+	r.setClassesCondition(status, merged, kind)
+	r.setExtraConfigCondition(status, merged, kind)
+	r.setDeprecatedKeysCondition(status, merged, kind)
+	r.setIgnoredFieldsCondition(status, merged)
+
+	return r.Client.Status().Update(ctx, obj)
+}
 
-	obj.SetCommonStatus(*status)
-	return r.Client.Status().Update(ctx, obj.GetObject())
+// setIgnoredFieldsCondition reports spec fields left out of the payload
+// because a field Home Assistant does not accept together with them wins.
+func (r *BaseReconciler) setIgnoredFieldsCondition(status *mqttv1alpha1.CommonStatus, obj registry.Entity) {
+	spec := obj.GetCommonSpec()
+
+	var ignored []string
+	if len(spec.Availability) > 0 && spec.AvailabilityTopic != "" {
+		ignored = append(ignored, "availabilityTopic is ignored because availability is set")
+	}
+
+	switch {
+	case len(ignored) > 0:
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeIgnoredFields, mqttv1alpha1.ConditionTrue, "IgnoredFields", strings.Join(ignored, "; "))
+	case hasCondition(status, mqttv1alpha1.ConditionTypeIgnoredFields):
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeIgnoredFields, mqttv1alpha1.ConditionFalse, "NoIgnoredFields", "All spec fields reach the discovery payload")
+	}
+}
+
+// setClassesCondition reports device classes, units and state classes Home
+// Assistant would not accept together. The entity is still published, as
+// Home Assistant creates it regardless.
+func (r *BaseReconciler) setClassesCondition(status *mqttv1alpha1.CommonStatus, obj registry.Entity, kind *registry.Kind) {
+	problems := classProblems(obj, kind)
+	switch {
+	case len(problems) > 0:
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeIncompatibleClasses, mqttv1alpha1.ConditionTrue, "IncompatibleClasses", strings.Join(problems, "; "))
+	case hasCondition(status, mqttv1alpha1.ConditionTypeIncompatibleClasses):
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeIncompatibleClasses, mqttv1alpha1.ConditionFalse, "Compatible", "Device class, unit and state class are compatible")
+	}
+}
+
+// setDeprecatedKeysCondition reports keys of the spec that Home Assistant
+// deprecated as of the targeted release, with what became of them.
+func (r *BaseReconciler) setDeprecatedKeysCondition(status *mqttv1alpha1.CommonStatus, obj registry.Entity, kind *registry.Kind) {
+	data, err := specPayload(obj, kind)
+	if err != nil {
+		return
+	}
+	deprecated := hass.Upgrade(r.TargetVersion, kind.ComponentOf(obj), data)
+
+	reason := "DeprecatedKeys"
+	messages := make([]string, 0, len(deprecated))
+	for _, d := range deprecated {
+		if d.Removed {
+			reason = "RemovedKeys"
+		}
+		messages = append(messages, d.Message)
+	}
+	switch {
+	case len(deprecated) > 0:
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeDeprecatedKeys, mqttv1alpha1.ConditionTrue, reason, strings.Join(messages, "; "))
+	case hasCondition(status, mqttv1alpha1.ConditionTypeDeprecatedKeys):
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeDeprecatedKeys, mqttv1alpha1.ConditionFalse, "Current", "No keys are deprecated in the targeted Home Assistant release")
+	}
+}
+
+// specPayload returns the payload keys set by the spec of obj, including
+// config and extraConfig.
+func specPayload(obj registry.Entity, kind *registry.Kind) (map[string]interface{}, error) {
+	pb, err := kind.Build(obj)
+	if err != nil {
+		return nil, err
+	}
+	if config, err := genericConfig(obj, kind); err == nil {
+		pb.Merge(config)
+	}
+	if extra, err := extraConfig(obj, kind); err == nil {
+		pb.Merge(extra)
+	}
+	return pb.BuildMap(), nil
+}
+
+// classProblems returns the problems hass.CheckClasses finds in the device
+// class, unit and state class of obj.
+func classProblems(obj registry.Entity, kind *registry.Kind) []string {
+	data, err := specPayload(obj, kind)
+	if err != nil {
+		return nil
+	}
+	return hass.CheckClasses(kind.ComponentOf(obj), hass.ClassesOf(data))
+}
+
+// UpdateStatusInvalid updates the status to reflect a discovery payload that
+// was refused by validation. Nothing was published, so the resource is left
+// as it is on the broker.
+func (r *BaseReconciler) UpdateStatusInvalid(ctx context.Context, obj registry.Entity, err error) error {
+	status := obj.GetCommonStatus()
+
+	r.SetCondition(status, mqttv1alpha1.ConditionTypePublished, mqttv1alpha1.ConditionFalse, "InvalidPayload", "Discovery payload is invalid")
+	r.SetCondition(status, mqttv1alpha1.ConditionTypeInvalidPayload, mqttv1alpha1.ConditionTrue, "InvalidPayload", err.Error())
+	r.setMQTTConnectedCondition(status)
+
+	return r.Client.Status().Update(ctx, obj)
 }
 
 // UpdateStatusFailed updates the status to reflect a failed publish.
-func (r *BaseReconciler) UpdateStatusFailed(ctx context.Context, obj EntityObject, reason, message string) error {
+func (r *BaseReconciler) UpdateStatusFailed(ctx context.Context, obj registry.Entity, reason, message string) error {
 	status := obj.GetCommonStatus()
 
 	r.SetCondition(status, mqttv1alpha1.ConditionTypePublished, mqttv1alpha1.ConditionFalse, reason, message)
+	r.setMQTTConnectedCondition(status)
 
-	obj.SetCommonStatus(*status)
-	return r.Client.Status().Update(ctx, obj.GetObject())
+	return r.Client.Status().Update(ctx, obj)
+}
+
+// setMQTTConnectedCondition records the current broker connection state,
+// including the last connection error (e.g. rejected credentials) if any.
+func (r *BaseReconciler) setMQTTConnectedCondition(status *mqttv1alpha1.CommonStatus) {
+	if r.MQTTClient.IsConnected() {
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeMQTTConnected, mqttv1alpha1.ConditionTrue, "Connected", "Connected to MQTT broker")
+		return
+	}
+
+	message := "Not connected to MQTT broker"
+	if err := r.MQTTClient.LastError(); err != nil {
+		message = fmt.Sprintf("%s: %v", message, err)
+	}
+	r.SetCondition(status, mqttv1alpha1.ConditionTypeMQTTConnected, mqttv1alpha1.ConditionFalse, "Disconnected", message)
 }
 
 // SetCondition updates or adds a condition to the status.
@@ -247,6 +523,16 @@ func (r *BaseReconciler) SetCondition(status *mqttv1alpha1.CommonStatus, condTyp
 	status.Conditions = append(status.Conditions, newCondition)
 }
 
+// hasCondition reports whether status has a condition of type condType.
+func hasCondition(status *mqttv1alpha1.CommonStatus, condType string) bool {
+	for _, c := range status.Conditions {
+		if c.Type == condType {
+			return true
+		}
+	}
+	return false
+}
+
 // EnsureFinalizer adds the finalizer if not present.
 func (r *BaseReconciler) EnsureFinalizer(ctx context.Context, obj client.Object) error {
 	if !controllerutil.ContainsFinalizer(obj, FinalizerName) {
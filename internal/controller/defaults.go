@@ -0,0 +1,181 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttdefaults,verbs=get;list;watch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=clustermqttdefaults,verbs=get;list;watch
+
+// appliedDefaults describes the defaults merged into an entity.
+type appliedDefaults struct {
+	// names are the defaults that select the entity, recorded in
+	// status.appliedDefaults.
+	names []string
+	// suggestedArea is used as device.suggestedArea when the device of the
+	// entity, inline or referenced, has none.
+	suggestedArea string
+}
+
+// mergeDefaults returns a copy of obj with the fields of the MQTTDefaults of
+// its namespace and the ClusterMQTTDefaults that select it merged into its
+// CommonSpec. Fields the entity sets are kept. MQTTDefaults are merged before
+// ClusterMQTTDefaults, each in order of name, and the first to set a field wins.
+func (r *BaseReconciler) mergeDefaults(ctx context.Context, obj registry.Entity, kind *registry.Kind) (registry.Entity, *appliedDefaults, error) {
+	// The CRDs may not be installed where resources are only rendered
+	var namespaced mqttv1alpha1.MQTTDefaultsList
+	if err := r.Client.List(ctx, &namespaced, client.InNamespace(obj.GetNamespace())); err != nil && !meta.IsNoMatchError(err) {
+		return nil, nil, fmt.Errorf("listing MQTTDefaults: %w", err)
+	}
+	var cluster mqttv1alpha1.ClusterMQTTDefaultsList
+	if err := r.Client.List(ctx, &cluster); err != nil && !meta.IsNoMatchError(err) {
+		return nil, nil, fmt.Errorf("listing ClusterMQTTDefaults: %w", err)
+	}
+	sort.Slice(namespaced.Items, func(i, j int) bool { return namespaced.Items[i].Name < namespaced.Items[j].Name })
+	sort.Slice(cluster.Items, func(i, j int) bool { return cluster.Items[i].Name < cluster.Items[j].Name })
+
+	merged := obj.DeepCopyObject().(registry.Entity)
+	applied := &appliedDefaults{}
+	if len(namespaced.Items) == 0 && len(cluster.Items) == 0 {
+		return merged, applied, nil
+	}
+
+	// The namespace is only fetched when a selector or label needs it
+	var namespaceLabels map[string]string
+	fetched := false
+	getNamespaceLabels := func() (map[string]string, error) {
+		if !fetched {
+			var ns corev1.Namespace
+			err := r.Client.Get(ctx, types.NamespacedName{Name: obj.GetNamespace()}, &ns)
+			if client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("fetching namespace %q: %w", obj.GetNamespace(), err)
+			}
+			namespaceLabels, fetched = ns.Labels, true
+		}
+		return namespaceLabels, nil
+	}
+
+	apply := func(name string, spec *mqttv1alpha1.MQTTDefaultsSpec) error {
+		if len(spec.Kinds) > 0 && !slices.Contains(spec.Kinds, kind.Kind) {
+			return nil
+		}
+		ok, err := matchesSelector(spec.Selector, obj.GetLabels())
+		if err != nil {
+			return fmt.Errorf("%s: selector: %w", name, err)
+		}
+		if !ok {
+			return nil
+		}
+		applied.names = append(applied.names, name)
+		mergeEntityDefaults(merged.GetCommonSpec(), &spec.EntityDefaults)
+		if applied.suggestedArea == "" && spec.SuggestedAreaLabel != "" {
+			namespaceLabels, err := getNamespaceLabels()
+			if err != nil {
+				return err
+			}
+			applied.suggestedArea = namespaceLabels[spec.SuggestedAreaLabel]
+		}
+		return nil
+	}
+
+	for i := range namespaced.Items {
+		d := &namespaced.Items[i]
+		if err := apply("MQTTDefaults/"+d.Name, &d.Spec); err != nil {
+			return nil, nil, err
+		}
+	}
+	for i := range cluster.Items {
+		d := &cluster.Items[i]
+		name := "ClusterMQTTDefaults/" + d.Name
+		namespaceLabels, err := getNamespaceLabels()
+		if err != nil {
+			return nil, nil, err
+		}
+		ok, err := matchesSelector(d.Spec.NamespaceSelector, namespaceLabels)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: namespaceSelector: %w", name, err)
+		}
+		if !ok {
+			continue
+		}
+		if err := apply(name, &d.Spec.MQTTDefaultsSpec); err != nil {
+			return nil, nil, err
+		}
+	}
+	return merged, applied, nil
+}
+
+// mergeEntityDefaults sets the fields of d on spec that spec leaves unset.
+func mergeEntityDefaults(spec *mqttv1alpha1.CommonSpec, d *mqttv1alpha1.EntityDefaults) {
+	if spec.EntityCategory == "" {
+		spec.EntityCategory = d.EntityCategory
+	}
+	if spec.EnabledByDefault == nil {
+		spec.EnabledByDefault = d.EnabledByDefault
+	}
+	if spec.Device == nil && spec.DeviceRef == nil {
+		spec.Device = d.Device.DeepCopy()
+		spec.DeviceRef = d.DeviceRef.DeepCopy()
+	}
+	if len(spec.Availability) == 0 && spec.AvailabilityTopic == "" {
+		spec.Availability = slices.Clone(d.Availability)
+		spec.AvailabilityTopic = d.AvailabilityTopic
+	}
+	if spec.AvailabilityMode == "" {
+		spec.AvailabilityMode = d.AvailabilityMode
+	}
+	if spec.Qos == nil {
+		spec.Qos = d.Qos
+	}
+	if spec.Retain == nil {
+		spec.Retain = d.Retain
+	}
+	if spec.Encoding == "" {
+		spec.Encoding = d.Encoding
+	}
+	if spec.RediscoverInterval == "" {
+		spec.RediscoverInterval = d.RediscoverInterval
+	}
+}
+
+// matchesSelector reports whether set matches selector. A nil selector
+// matches everything.
+func matchesSelector(selector *metav1.LabelSelector, set map[string]string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+	return s.Matches(labels.Set(set)), nil
+}
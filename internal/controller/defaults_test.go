@@ -0,0 +1,284 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/mqtt"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+func TestMergeEntityDefaults(t *testing.T) {
+	qos := 0
+	defaults := mqttv1alpha1.EntityDefaults{
+		EntityCategory:     "diagnostic",
+		DeviceRef:          &mqttv1alpha1.DeviceRef{Name: "hub"},
+		Availability:       []mqttv1alpha1.AvailabilityConfig{{Topic: "hub/status"}},
+		Qos:                &qos,
+		RediscoverInterval: "1h",
+	}
+
+	tests := []struct {
+		name string
+		spec mqttv1alpha1.CommonSpec
+		want mqttv1alpha1.CommonSpec
+	}{
+		{
+			name: "unset fields are defaulted",
+			spec: mqttv1alpha1.CommonSpec{},
+			want: mqttv1alpha1.CommonSpec{
+				EntityMetadata:     mqttv1alpha1.EntityMetadata{EntityCategory: "diagnostic"},
+				DeviceRef:          &mqttv1alpha1.DeviceRef{Name: "hub"},
+				Availability:       []mqttv1alpha1.AvailabilityConfig{{Topic: "hub/status"}},
+				Qos:                &qos,
+				RediscoverInterval: "1h",
+			},
+		},
+		{
+			name: "set fields are kept",
+			spec: mqttv1alpha1.CommonSpec{
+				EntityMetadata:     mqttv1alpha1.EntityMetadata{EntityCategory: "config"},
+				RediscoverInterval: "5m",
+			},
+			want: mqttv1alpha1.CommonSpec{
+				EntityMetadata:     mqttv1alpha1.EntityMetadata{EntityCategory: "config"},
+				DeviceRef:          &mqttv1alpha1.DeviceRef{Name: "hub"},
+				Availability:       []mqttv1alpha1.AvailabilityConfig{{Topic: "hub/status"}},
+				Qos:                &qos,
+				RediscoverInterval: "5m",
+			},
+		},
+		{
+			name: "inline device and availability topic replace the defaults",
+			spec: mqttv1alpha1.CommonSpec{
+				Device:            &mqttv1alpha1.DeviceBlock{Name: "Lamp"},
+				AvailabilityTopic: "lamp/status",
+			},
+			want: mqttv1alpha1.CommonSpec{
+				EntityMetadata:     mqttv1alpha1.EntityMetadata{EntityCategory: "diagnostic"},
+				Device:             &mqttv1alpha1.DeviceBlock{Name: "Lamp"},
+				AvailabilityTopic:  "lamp/status",
+				Qos:                &qos,
+				RediscoverInterval: "1h",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			mergeEntityDefaults(&spec, &defaults)
+			if !reflect.DeepEqual(spec, tt.want) {
+				t.Errorf("merged spec = %+v, want %+v", spec, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntityReconciler_Defaults(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	qos := 0
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "home",
+		Labels: map[string]string{"site": "house", "area": "Kitchen"},
+	}}
+	heating := &mqttv1alpha1.MQTTDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "heating", Namespace: "home"},
+		Spec: mqttv1alpha1.MQTTDefaultsSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "heating"}},
+			EntityDefaults: mqttv1alpha1.EntityDefaults{
+				Device:       &mqttv1alpha1.DeviceBlock{Name: "Boiler", Identifiers: []string{"boiler"}},
+				Availability: []mqttv1alpha1.AvailabilityConfig{{Topic: "boiler/status"}},
+				Qos:          &qos,
+			},
+			SuggestedAreaLabel: "area",
+		},
+	}
+	// Not selecting the sensor by kind
+	switches := &mqttv1alpha1.MQTTDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "switches", Namespace: "home"},
+		Spec: mqttv1alpha1.MQTTDefaultsSpec{
+			Kinds:          []string{"MQTTSwitch"},
+			EntityDefaults: mqttv1alpha1.EntityDefaults{Encoding: "ascii"},
+		},
+	}
+	site := &mqttv1alpha1.ClusterMQTTDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "site"},
+		Spec: mqttv1alpha1.ClusterMQTTDefaultsSpec{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"site": "house"}},
+			MQTTDefaultsSpec: mqttv1alpha1.MQTTDefaultsSpec{
+				EntityDefaults: mqttv1alpha1.EntityDefaults{EntityCategory: "diagnostic", Qos: new(int)},
+			},
+		},
+	}
+	// Not selecting the namespace
+	office := &mqttv1alpha1.ClusterMQTTDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "office"},
+		Spec: mqttv1alpha1.ClusterMQTTDefaultsSpec{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"site": "office"}},
+			MQTTDefaultsSpec: mqttv1alpha1.MQTTDefaultsSpec{
+				EntityDefaults: mqttv1alpha1.EntityDefaults{RediscoverInterval: "1h"},
+			},
+		},
+	}
+	sensor := &mqttv1alpha1.MQTTSensor{
+		ObjectMeta: metav1.ObjectMeta{Name: "flow", Namespace: "home", Labels: map[string]string{"app": "heating"}},
+		Spec: mqttv1alpha1.MQTTSensorSpec{
+			CommonSpec: mqttv1alpha1.CommonSpec{EntityMetadata: mqttv1alpha1.EntityMetadata{EntityCategory: "config"}},
+			StateTopic: "boiler/flow",
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(namespace, heating, switches, site, office, sensor).
+		WithStatusSubresource(&mqttv1alpha1.MQTTSensor{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTSensor"), logr.Discard(), mockClient)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(sensor)}
+	result, err := r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("RequeueAfter = %v, want no rediscover interval", result.RequeueAfter)
+	}
+
+	msgs := mockClient.GetPublishedMessages()
+	if len(msgs) != 1 {
+		t.Fatalf("published %d messages, want 1", len(msgs))
+	}
+	if msgs[0].QoS != 0 {
+		t.Errorf("QoS = %d, want the default 0", msgs[0].QoS)
+	}
+	var data struct {
+		EntityCategory string                   `json:"entity_category"`
+		Encoding       string                   `json:"encoding"`
+		Device         map[string]interface{}   `json:"device"`
+		Availability   []map[string]interface{} `json:"availability"`
+	}
+	if err := json.Unmarshal(msgs[0].Payload, &data); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if data.EntityCategory != "config" {
+		t.Errorf("entity_category = %q, want the one of the entity", data.EntityCategory)
+	}
+	if data.Encoding != "" {
+		t.Errorf("encoding = %q, want none from defaults of another kind", data.Encoding)
+	}
+	if data.Device["name"] != "Boiler" || data.Device["suggested_area"] != "Kitchen" {
+		t.Errorf("device = %v, want Boiler in the area of the namespace label", data.Device)
+	}
+	if len(data.Availability) != 1 || data.Availability[0]["topic"] != "boiler/status" {
+		t.Errorf("availability = %v, want the default", data.Availability)
+	}
+
+	// The defaults are recorded in the status, not merged into the spec
+	var got mqttv1alpha1.MQTTSensor
+	if err := c.Get(context.Background(), req.NamespacedName, &got); err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	want := []string{"MQTTDefaults/heating", "ClusterMQTTDefaults/site"}
+	if !reflect.DeepEqual(got.Status.AppliedDefaults, want) {
+		t.Errorf("AppliedDefaults = %v, want %v", got.Status.AppliedDefaults, want)
+	}
+	if got.Spec.Device != nil || got.Spec.Availability != nil || got.Spec.Qos != nil {
+		t.Errorf("spec = %+v, want the defaults left out", got.Spec.CommonSpec)
+	}
+
+	// Changing the defaults or the namespace reconciles the entities
+	for _, obj := range []client.Object{heating, site, namespace} {
+		requests := r.entitiesInNamespace(context.Background(), obj)
+		if len(requests) != 1 || requests[0].NamespacedName != req.NamespacedName {
+			t.Errorf("entitiesInNamespace(%s) = %v, want %v", obj.GetName(), requests, req.NamespacedName)
+		}
+	}
+}
+
+func TestEntityReconciler_DefaultsChecked(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	defaults := &mqttv1alpha1.MQTTDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "availability", Namespace: "home"},
+		Spec: mqttv1alpha1.MQTTDefaultsSpec{
+			EntityDefaults: mqttv1alpha1.EntityDefaults{
+				Availability:      []mqttv1alpha1.AvailabilityConfig{{Topic: "bridge/status"}},
+				AvailabilityTopic: "boiler/status",
+			},
+		},
+	}
+	sensor := &mqttv1alpha1.MQTTSensor{
+		ObjectMeta: metav1.ObjectMeta{Name: "flow", Namespace: "home"},
+		Spec:       mqttv1alpha1.MQTTSensorSpec{StateTopic: "boiler/flow"},
+	}
+	lists := 0
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(defaults, sensor).
+		WithStatusSubresource(&mqttv1alpha1.MQTTSensor{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if _, ok := list.(*mqttv1alpha1.MQTTDefaultsList); ok {
+					lists++
+				}
+				return c.List(ctx, list, opts...)
+			},
+		}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTSensor"), logr.Discard(), mockClient)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(sensor)}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	if lists != 1 {
+		t.Errorf("listed MQTTDefaults %d times, want the defaults merged once", lists)
+	}
+
+	// The spec checks see the fields the defaults set
+	var got mqttv1alpha1.MQTTSensor
+	if err := c.Get(context.Background(), req.NamespacedName, &got); err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeIgnoredFields)
+	if cond == nil || cond.Status != mqttv1alpha1.ConditionTrue {
+		t.Errorf("IgnoredFields condition = %+v, want True for the merged spec", cond)
+	}
+}
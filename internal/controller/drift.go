@@ -0,0 +1,423 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/metrics"
+	"github.com/spontus/hass-crds/internal/mqtt"
+	"github.com/spontus/hass-crds/internal/registry"
+	"github.com/spontus/hass-crds/internal/topic"
+)
+
+const (
+	// driftSubscription matches every discovery config topic. It deliberately
+	// differs from the filter the garbage collector subscribes to and later
+	// unsubscribes from, so the two subscriptions never replace each other.
+	driftSubscription = topic.DefaultDiscoveryPrefix + "/+/+/+/+"
+
+	// driftBaseDelay and driftMaxDelay bound the backoff between repairs of
+	// a config that keeps being overwritten, e.g. by another publisher.
+	driftBaseDelay = time.Second
+	driftMaxDelay  = 5 * time.Minute
+
+	// maxDriftKeys limits the number of keys listed in a diff summary.
+	maxDriftKeys = 10
+)
+
+// driftState tracks where an expectation is in the detect/repair cycle.
+type driftState int
+
+const (
+	driftInSync driftState = iota
+	// driftDetected: a mismatch was received and a repair is queued.
+	driftDetected
+	// driftRepairing: the expected config was re-published and its echo is awaited.
+	driftRepairing
+	// driftRepaired: the echo matched and the condition has to be cleared.
+	driftRepaired
+)
+
+// driftExpectation is the config last published for a resource.
+type driftExpectation struct {
+	kind      string
+	namespace string
+	name      string
+	uid       types.UID
+	qos       byte
+	payload   []byte
+	// previous is the config published before payload. Until payload is
+	// echoed back, a retained copy of previous is our own stale message
+	// rather than drift.
+	previous  []byte
+	state     driftState
+	reason    string
+	diff      string
+	lastDrift time.Time
+}
+
+// DriftDetector keeps a long-lived subscription on the discovery topics and
+// compares every retained config it receives with the config last published
+// for the owning resource. A config that was overwritten or cleared by
+// someone else is re-published and the resource gets a Drifted condition
+// with a summary of the difference; the condition is cleared once the
+// broker echoes the repaired config.
+//
+// Expectations are recorded by BaseReconciler before every publish, so only
+// topics this controller publishes are watched.
+type DriftDetector struct {
+	client     client.Client
+	mqttClient mqtt.Client
+	log        logr.Logger
+	base       BaseReconciler
+
+	mu       sync.Mutex
+	expected map[string]*driftExpectation
+	queue    workqueue.RateLimitingInterface
+}
+
+// NewDriftDetector creates a drift detector publishing repairs with mqttClient.
+func NewDriftDetector(k8sClient client.Client, mqttClient mqtt.Client, log logr.Logger) *DriftDetector {
+	log = log.WithName("drift-detector")
+	return &DriftDetector{
+		client:     k8sClient,
+		mqttClient: mqttClient,
+		log:        log,
+		base:       BaseReconciler{Client: k8sClient, Log: log, MQTTClient: mqttClient},
+		expected:   make(map[string]*driftExpectation),
+		queue: workqueue.NewRateLimitingQueue(
+			workqueue.NewItemExponentialFailureRateLimiter(driftBaseDelay, driftMaxDelay)),
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Expectations
+// are only recorded by the reconcilers, which run on the leader.
+func (d *DriftDetector) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable. It subscribes to the discovery topics and
+// repairs drift until ctx is done.
+func (d *DriftDetector) Start(ctx context.Context) error {
+	if !d.subscribe(ctx) {
+		return nil
+	}
+	d.log.Info("Starting drift detector", "topic", driftSubscription)
+
+	go func() {
+		<-ctx.Done()
+		d.queue.ShutDown()
+	}()
+
+	for d.processNext(ctx) {
+	}
+
+	unsubCtx, cancel := context.WithTimeout(context.Background(), mqtt.DefaultWriteTimeout)
+	defer cancel()
+	if err := d.mqttClient.Unsubscribe(unsubCtx, driftSubscription); err != nil {
+		d.log.V(1).Info("Failed to unsubscribe from discovery topics", "error", err.Error())
+	}
+	return nil
+}
+
+// subscribe subscribes to the discovery topics, retrying until it succeeds or
+// ctx is done. The MQTT client restores the subscription after reconnects.
+func (d *DriftDetector) subscribe(ctx context.Context) bool {
+	backoff := driftBaseDelay
+	for {
+		err := d.mqttClient.Subscribe(ctx, driftSubscription, 0, d.handleMessage)
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		d.log.Error(err, "Failed to subscribe to discovery topics, will retry", "topic", driftSubscription, "retryIn", backoff)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, driftMaxDelay)
+	}
+}
+
+// Expect records payload as the config published for obj on discoveryTopic.
+// It is safe to call on a nil detector.
+func (d *DriftDetector) Expect(obj registry.Entity, kind, discoveryTopic string, payload []byte, qos byte) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	e, ok := d.expected[discoveryTopic]
+	if !ok || e.uid != obj.GetUID() {
+		e = &driftExpectation{}
+		d.expected[discoveryTopic] = e
+	} else if !bytes.Equal(e.payload, payload) {
+		e.previous = e.payload
+	}
+	e.kind = kind
+	e.namespace = obj.GetNamespace()
+	e.name = obj.GetName()
+	e.uid = obj.GetUID()
+	e.qos = qos
+	e.payload = payload
+}
+
+// Forget stops watching discoveryTopic, e.g. before its config is deleted.
+// It is safe to call on a nil detector.
+func (d *DriftDetector) Forget(discoveryTopic string) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	delete(d.expected, discoveryTopic)
+	d.mu.Unlock()
+	d.queue.Forget(discoveryTopic)
+}
+
+// handleMessage compares a received discovery config with the expected one
+// and queues a repair on mismatch.
+func (d *DriftDetector) handleMessage(t string, data []byte) {
+	if _, err := topic.ParseDiscoveryTopic(t); err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	e, ok := d.expected[t]
+	if !ok {
+		return
+	}
+
+	if samePayload(e.payload, data) {
+		e.previous = nil
+		if e.state == driftRepairing {
+			e.state = driftRepaired
+			d.queue.Add(t)
+		}
+		return
+	}
+	if e.previous != nil && samePayload(e.previous, data) {
+		return
+	}
+
+	// Reset the backoff once the config has been left alone for a while
+	if time.Since(e.lastDrift) > driftMaxDelay {
+		d.queue.Forget(t)
+	}
+	e.lastDrift = time.Now()
+	e.reason = "Overwritten"
+	if len(data) == 0 {
+		e.reason = "Cleared"
+	}
+	e.diff = driftSummary(e.payload, data)
+	e.state = driftDetected
+	metrics.DriftDetectedTotal.WithLabelValues(e.kind).Inc()
+	d.log.Info("Discovery config drifted", "topic", t, "kind", e.kind, "namespace", e.namespace, "name", e.name, "diff", e.diff)
+
+	d.queue.AddRateLimited(t)
+}
+
+// processNext handles one queued topic. It returns false once the queue is shut down.
+func (d *DriftDetector) processNext(ctx context.Context) bool {
+	item, shutdown := d.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer d.queue.Done(item)
+
+	t := item.(string)
+	if err := d.sync(ctx, t); err != nil {
+		if ctx.Err() == nil {
+			d.log.Error(err, "Failed to repair drifted discovery config, will retry", "topic", t)
+			d.queue.AddRateLimited(t)
+		}
+	}
+	return true
+}
+
+// sync repairs a drifted config or clears the Drifted condition after a repair.
+func (d *DriftDetector) sync(ctx context.Context, t string) error {
+	d.mu.Lock()
+	e, ok := d.expected[t]
+	if !ok {
+		d.mu.Unlock()
+		return nil
+	}
+	snapshot := *e
+	d.mu.Unlock()
+
+	switch snapshot.state {
+	case driftDetected:
+		message := "Retained discovery config was cleared and has been re-published"
+		if snapshot.reason != "Cleared" {
+			message = fmt.Sprintf("Retained discovery config was overwritten (%s) and has been re-published", snapshot.diff)
+		}
+		if err := d.setDrifted(ctx, &snapshot, mqttv1alpha1.ConditionTrue, snapshot.reason, message); err != nil {
+			return fmt.Errorf("updating status: %w", err)
+		}
+
+		pubCtx := withSource(ctx, snapshot.uid, snapshot.kind, snapshot.namespace, snapshot.name)
+		if err := d.mqttClient.Publish(pubCtx, t, snapshot.payload, snapshot.qos, DefaultRetain); err != nil {
+			return fmt.Errorf("re-publishing discovery config: %w", err)
+		}
+		metrics.DriftRepairsTotal.WithLabelValues(snapshot.kind).Inc()
+		d.log.Info("Re-published drifted discovery config", "topic", t, "kind", snapshot.kind, "namespace", snapshot.namespace, "name", snapshot.name)
+
+		d.transition(t, e, driftDetected, driftRepairing)
+
+	case driftRepaired:
+		if err := d.setDrifted(ctx, &snapshot, mqttv1alpha1.ConditionFalse, "Repaired", "Retained discovery config matches the resource"); err != nil {
+			return fmt.Errorf("updating status: %w", err)
+		}
+		d.transition(t, e, driftRepaired, driftInSync)
+	}
+	return nil
+}
+
+// transition moves the expectation for t from one state to the next, unless
+// it was replaced or moved on while the lock was released.
+func (d *DriftDetector) transition(t string, e *driftExpectation, from, to driftState) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.expected[t] == e && e.state == from {
+		e.state = to
+	}
+}
+
+// setDrifted sets the Drifted condition on the resource behind e. The
+// resource is handled as unstructured so one detector serves every kind.
+func (d *DriftDetector) setDrifted(ctx context.Context, e *driftExpectation, condStatus, reason, message string) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(mqttv1alpha1.GroupVersion.WithKind(e.kind))
+	if err := d.client.Get(ctx, types.NamespacedName{Namespace: e.namespace, Name: e.name}, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if obj.GetUID() != e.uid {
+		return nil
+	}
+
+	var status mqttv1alpha1.CommonStatus
+	if raw, ok := obj.Object["status"].(map[string]interface{}); ok {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &status); err != nil {
+			return fmt.Errorf("decoding status: %w", err)
+		}
+	}
+	d.base.SetCondition(&status, mqttv1alpha1.ConditionTypeDrifted, condStatus, reason, message)
+
+	converted, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&status)
+	if err != nil {
+		return fmt.Errorf("encoding status: %w", err)
+	}
+	if err := unstructured.SetNestedField(obj.Object, converted["conditions"], "status", "conditions"); err != nil {
+		return err
+	}
+	return d.client.Status().Update(ctx, obj)
+}
+
+// samePayload reports whether two discovery configs are equal as JSON,
+// ignoring key order and formatting.
+func samePayload(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// driftSummary describes how the received config differs from the expected
+// one by listing changed, missing and unexpected top-level keys.
+func driftSummary(expected, received []byte) string {
+	if len(received) == 0 {
+		return "cleared"
+	}
+
+	var want, got map[string]interface{}
+	if err := json.Unmarshal(received, &got); err != nil {
+		return "replaced with a payload that is not a JSON object"
+	}
+	if err := json.Unmarshal(expected, &want); err != nil {
+		return "replaced"
+	}
+
+	var changed, missing, unexpected []string
+	for k, v := range want {
+		g, ok := got[k]
+		switch {
+		case !ok:
+			missing = append(missing, k)
+		case !reflect.DeepEqual(v, g):
+			changed = append(changed, k)
+		}
+	}
+	for k := range got {
+		if _, ok := want[k]; !ok {
+			unexpected = append(unexpected, k)
+		}
+	}
+
+	var parts []string
+	for _, group := range []struct {
+		label string
+		keys  []string
+	}{
+		{"changed", changed},
+		{"missing", missing},
+		{"unexpected", unexpected},
+	} {
+		if len(group.keys) == 0 {
+			continue
+		}
+		sort.Strings(group.keys)
+		keys := group.keys
+		if len(keys) > maxDriftKeys {
+			keys = append(keys[:maxDriftKeys:maxDriftKeys], fmt.Sprintf("and %d more", len(group.keys)-maxDriftKeys))
+		}
+		parts = append(parts, group.label+": "+strings.Join(keys, ", "))
+	}
+	if len(parts) == 0 {
+		return "replaced"
+	}
+	return strings.Join(parts, "; ")
+}
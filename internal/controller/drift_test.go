@@ -0,0 +1,171 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/mqtt"
+)
+
+const driftTestTopic = "homeassistant/switch/default/porch/config"
+
+func newTestDriftDetector(t *testing.T) (*DriftDetector, *mqtt.MockClient, client.Client, *mqttv1alpha1.MQTTSwitch) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	sw := &mqttv1alpha1.MQTTSwitch{
+		ObjectMeta: metav1.ObjectMeta{Name: "porch", Namespace: "default", UID: "uid-1"},
+	}
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(sw).
+		WithStatusSubresource(&mqttv1alpha1.MQTTSwitch{}).
+		Build()
+
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	d := NewDriftDetector(k8sClient, mockClient, logr.Discard())
+	d.queue = workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, time.Millisecond))
+	if !d.subscribe(context.Background()) {
+		t.Fatal("subscribe() failed")
+	}
+	return d, mockClient, k8sClient, sw
+}
+
+// drifted returns the Drifted condition of the test switch, or nil.
+func drifted(t *testing.T, c client.Client) *mqttv1alpha1.Condition {
+	t.Helper()
+	var sw mqttv1alpha1.MQTTSwitch
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "porch"}, &sw); err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	for i, cond := range sw.Status.Conditions {
+		if cond.Type == mqttv1alpha1.ConditionTypeDrifted {
+			return &sw.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func TestDriftDetector_RepairsOverwrittenConfig(t *testing.T) {
+	d, mockClient, k8sClient, obj := newTestDriftDetector(t)
+	expected := []byte(`{"name":"Porch","command_topic":"porch/set"}`)
+	d.Expect(obj, "MQTTSwitch", driftTestTopic, expected, 1)
+
+	// A matching config, even with different key order, is not drift
+	mockClient.SimulateMessage(driftTestTopic, []byte(`{"command_topic":"porch/set","name":"Porch"}`))
+	if d.queue.Len() != 0 {
+		t.Fatalf("queue length = %d after matching config, want 0", d.queue.Len())
+	}
+
+	mockClient.SimulateMessage(driftTestTopic, []byte(`{"name":"Hijacked","command_topic":"porch/set","icon":"mdi:skull"}`))
+	d.processNext(context.Background())
+
+	msgs := mockClient.GetPublishedMessages()
+	if len(msgs) != 1 || msgs[0].Topic != driftTestTopic || string(msgs[0].Payload) != string(expected) || !msgs[0].Retain {
+		t.Fatalf("published %+v, want the expected config re-published", msgs)
+	}
+	cond := drifted(t, k8sClient)
+	if cond == nil || cond.Status != mqttv1alpha1.ConditionTrue || cond.Reason != "Overwritten" {
+		t.Fatalf("Drifted condition = %+v, want True/Overwritten", cond)
+	}
+	if !strings.Contains(cond.Message, "changed: name; unexpected: icon") {
+		t.Errorf("Drifted message = %q, want diff summary", cond.Message)
+	}
+
+	// The broker echoes the repaired config
+	mockClient.SimulateMessage(driftTestTopic, expected)
+	d.processNext(context.Background())
+	cond = drifted(t, k8sClient)
+	if cond == nil || cond.Status != mqttv1alpha1.ConditionFalse || cond.Reason != "Repaired" {
+		t.Fatalf("Drifted condition = %+v, want False/Repaired", cond)
+	}
+}
+
+func TestDriftDetector_RepairsClearedConfig(t *testing.T) {
+	d, mockClient, k8sClient, obj := newTestDriftDetector(t)
+	d.Expect(obj, "MQTTSwitch", driftTestTopic, []byte(`{"name":"Porch"}`), 1)
+
+	mockClient.SimulateMessage(driftTestTopic, nil)
+	d.processNext(context.Background())
+
+	if got := len(mockClient.GetPublishedMessages()); got != 1 {
+		t.Fatalf("published %d messages, want 1", got)
+	}
+	if cond := drifted(t, k8sClient); cond == nil || cond.Status != mqttv1alpha1.ConditionTrue || cond.Reason != "Cleared" {
+		t.Fatalf("Drifted condition = %+v, want True/Cleared", cond)
+	}
+}
+
+func TestDriftDetector_IgnoresOwnAndForgottenConfigs(t *testing.T) {
+	d, mockClient, _, obj := newTestDriftDetector(t)
+	d.Expect(obj, "MQTTSwitch", driftTestTopic, []byte(`{"name":"Porch"}`), 1)
+	d.Expect(obj, "MQTTSwitch", driftTestTopic, []byte(`{"name":"Front porch"}`), 1)
+
+	// The previous config is still retained until the new publish lands
+	mockClient.SimulateMessage(driftTestTopic, []byte(`{"name":"Porch"}`))
+	if d.queue.Len() != 0 {
+		t.Fatalf("queue length = %d after stale config, want 0", d.queue.Len())
+	}
+
+	// Topics we do not publish are not watched
+	mockClient.SimulateMessage("homeassistant/switch/default/other/config", nil)
+
+	// Nor are deleted ones
+	d.Forget(driftTestTopic)
+	mockClient.SimulateMessage(driftTestTopic, nil)
+	if d.queue.Len() != 0 {
+		t.Errorf("queue length = %d, want 0", d.queue.Len())
+	}
+}
+
+func TestDriftSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		received string
+		want     string
+	}{
+		{"cleared", "", "cleared"},
+		{"not an object", "on", "replaced with a payload that is not a JSON object"},
+		{"changed", `{"name":"x","state_topic":"s"}`, "changed: name"},
+		{"missing and unexpected", `{"name":"Porch","icon":"mdi:x"}`, "missing: state_topic; unexpected: icon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := driftSummary([]byte(`{"name":"Porch","state_topic":"s"}`), []byte(tt.received))
+			if got != tt.want {
+				t.Errorf("driftSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
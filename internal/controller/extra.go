@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/payload"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// commonTypedKeys are the payload keys of the CommonSpec fields the
+// controller resolves itself, which are typed fields all the same.
+var commonTypedKeys = []string{"availability", "availability_mode"}
+
+// extraConfig decodes the extraConfig of an entity.
+func extraConfig(obj registry.Entity, kind *registry.Kind) (map[string]interface{}, error) {
+	return decodeObject(obj.GetCommonSpec().ExtraConfig, "extraConfig", kind.ComponentOf(obj))
+}
+
+// genericConfig decodes the config of a generic entity. It is nil for other
+// kinds.
+func genericConfig(obj registry.Entity, kind *registry.Kind) (map[string]interface{}, error) {
+	g, ok := obj.(registry.Generic)
+	if !ok {
+		return nil, nil
+	}
+	return decodeObject(g.GetConfig(), "config", kind.ComponentOf(obj))
+}
+
+// decodeObject decodes the free-form object in field, reporting anything
+// else as invalid.
+func decodeObject(raw *runtime.RawExtension, field, component string) (map[string]interface{}, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw.Raw, &m); err != nil {
+		return nil, &hass.ValidationError{
+			Component: component,
+			Problems:  []string{fmt.Sprintf("%s must be an object: %v", field, err)},
+		}
+	}
+	return m, nil
+}
+
+// extraConfigOverrides returns the extraConfig keys that override typed
+// fields, and those left out because the controller manages them.
+func extraConfigOverrides(extra map[string]interface{}, kind *registry.Kind) (shadowed, ignored []string) {
+	for key := range extra {
+		switch {
+		case slices.Contains(payload.ReservedKeys, key):
+			ignored = append(ignored, key)
+		case slices.Contains(kind.Keys, key) || slices.Contains(commonTypedKeys, key):
+			shadowed = append(shadowed, key)
+		}
+	}
+	sort.Strings(shadowed)
+	sort.Strings(ignored)
+	return shadowed, ignored
+}
+
+// setExtraConfigCondition reports extraConfig keys that override typed
+// fields, which are better set through the field, and keys that are left
+// out.
+func (r *BaseReconciler) setExtraConfigCondition(status *mqttv1alpha1.CommonStatus, obj registry.Entity, kind *registry.Kind) {
+	extra, err := extraConfig(obj, kind)
+	if err != nil {
+		return
+	}
+	shadowed, ignored := extraConfigOverrides(extra, kind)
+
+	var problems []string
+	if len(shadowed) > 0 {
+		problems = append(problems, "extraConfig overrides typed fields: "+strings.Join(shadowed, ", "))
+	}
+	if len(ignored) > 0 {
+		problems = append(problems, "extraConfig keys managed by the controller are ignored: "+strings.Join(ignored, ", "))
+	}
+
+	switch {
+	case len(problems) > 0:
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeExtraConfigOverrides, mqttv1alpha1.ConditionTrue, "Overrides", strings.Join(problems, "; "))
+	case hasCondition(status, mqttv1alpha1.ConditionTypeExtraConfigOverrides):
+		r.SetCondition(status, mqttv1alpha1.ConditionTypeExtraConfigOverrides, mqttv1alpha1.ConditionFalse, "NoOverrides", "extraConfig only sets keys without a typed field")
+	}
+}
+
+// keys returns the keys of m.
+func keys(m map[string]interface{}) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -1,179 +0,0 @@
-/*
-Copyright 2026.
-
-Licensed under the Apache License, Version 2.0 (the "License");
-you may not use this file except in compliance with the License.
-You may obtain a copy of the License at
-
-    http://www.apache.org/licenses/LICENSE-2.0
-
-Unless required by applicable law or agreed to in writing, software
-distributed under the License is distributed on an "AS IS" BASIS,
-WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-See the License for the specific language governing permissions and
-limitations under the License.
-*/
-
-package controller
-
-import (
-	"context"
-	"time"
-
-	"github.com/go-logr/logr"
-	"k8s.io/apimachinery/pkg/runtime"
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
-	"github.com/spontus/hass-crds/internal/mqtt"
-	"github.com/spontus/hass-crds/internal/payload"
-)
-
-type MQTTCoverReconciler struct {
-	client.Client
-	Scheme     *runtime.Scheme
-	Log        logr.Logger
-	MQTTClient mqtt.Client
-	base       BaseReconciler
-}
-
-func NewMQTTCoverReconciler(c client.Client, scheme *runtime.Scheme, log logr.Logger, mqttClient mqtt.Client) *MQTTCoverReconciler {
-	return &MQTTCoverReconciler{
-		Client:     c,
-		Scheme:     scheme,
-		Log:        log.WithName("mqttcover"),
-		MQTTClient: mqttClient,
-		base: BaseReconciler{
-			Client:     c,
-			Log:        log.WithName("mqttcover"),
-			MQTTClient: mqttClient,
-		},
-	}
-}
-
-// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcovers,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcovers/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcovers/finalizers,verbs=update
-
-func (r *MQTTCoverReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	log := r.Log.WithValues("mqttcover", req.NamespacedName)
-
-	var obj mqttv1alpha1.MQTTCover
-	if err := r.Get(ctx, req.NamespacedName, &obj); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
-	wrapper := &mqttCoverWrapper{&obj}
-
-	if r.base.IsBeingDeleted(&obj) {
-		if err := r.base.HandleDeletion(ctx, wrapper, "MQTTCover"); err != nil {
-			log.Error(err, "Failed to handle deletion")
-			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
-		}
-		if err := r.base.RemoveFinalizer(ctx, &obj); err != nil {
-			return ctrl.Result{}, err
-		}
-		return ctrl.Result{}, nil
-	}
-
-	if err := r.base.EnsureFinalizer(ctx, &obj); err != nil {
-		return ctrl.Result{}, err
-	}
-
-	if err := r.base.PublishDiscovery(ctx, wrapper, "MQTTCover", r.buildPayload); err != nil {
-		log.Error(err, "Failed to publish discovery")
-		if statusErr := r.base.UpdateStatusFailed(ctx, wrapper, "PublishFailed", err.Error()); statusErr != nil {
-			log.Error(statusErr, "Failed to update status")
-		}
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
-	}
-
-	if err := r.base.UpdateStatusPublished(ctx, wrapper, "MQTTCover"); err != nil {
-		log.Error(err, "Failed to update status")
-		return ctrl.Result{}, err
-	}
-
-	requeueAfter := time.Duration(0)
-	if obj.Spec.RediscoverInterval != "" {
-		d, err := ParseRediscoverInterval(obj.Spec.RediscoverInterval)
-		if err == nil && d > 0 {
-			requeueAfter = d
-		}
-	}
-
-	if requeueAfter > 0 {
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
-	}
-	return ctrl.Result{}, nil
-}
-
-func (r *MQTTCoverReconciler) buildPayload(obj EntityObject, uniqueID string) (*payload.Builder, error) {
-	entity := obj.(*mqttCoverWrapper).MQTTCover
-	spec := &entity.Spec
-
-	pb := payload.New()
-
-	pb.Set("name", spec.Name)
-	pb.Set("commandTopic", spec.CommandTopic)
-	pb.Set("stateTopic", spec.StateTopic)
-	pb.Set("valueTemplate", spec.ValueTemplate)
-	pb.Set("positionTopic", spec.PositionTopic)
-	pb.Set("setPositionTopic", spec.SetPositionTopic)
-	pb.Set("setPositionTemplate", spec.SetPositionTemplate)
-	pb.Set("positionTemplate", spec.PositionTemplate)
-	pb.Set("tiltCommandTopic", spec.TiltCommandTopic)
-	pb.Set("tiltStatusTopic", spec.TiltStatusTopic)
-	pb.Set("tiltStatusTemplate", spec.TiltStatusTemplate)
-	pb.Set("payloadOpen", spec.PayloadOpen)
-	pb.Set("payloadClose", spec.PayloadClose)
-	pb.Set("payloadStop", spec.PayloadStop)
-	pb.Set("stateOpen", spec.StateOpen)
-	pb.Set("stateClosed", spec.StateClosed)
-	pb.Set("stateOpening", spec.StateOpening)
-	pb.Set("stateClosing", spec.StateClosing)
-	pb.Set("stateStopped", spec.StateStopped)
-	pb.Set("positionOpen", spec.PositionOpen)
-	pb.Set("positionClosed", spec.PositionClosed)
-	pb.Set("tiltMin", spec.TiltMin)
-	pb.Set("tiltMax", spec.TiltMax)
-	pb.Set("deviceClass", spec.DeviceClass)
-	pb.Set("optimistic", spec.Optimistic)
-	pb.Set("icon", spec.Icon)
-	pb.Set("entityCategory", spec.EntityCategory)
-	pb.Set("enabledByDefault", spec.EnabledByDefault)
-	pb.Set("objectId", spec.ObjectId)
-	pb.Set("qos", spec.Qos)
-	pb.Set("retain", spec.Retain)
-	pb.Set("encoding", spec.Encoding)
-	pb.Set("jsonAttributesTopic", spec.JsonAttributesTopic)
-	pb.Set("jsonAttributesTemplate", spec.JsonAttributesTemplate)
-
-	return pb, nil
-}
-
-func (r *MQTTCoverReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&mqttv1alpha1.MQTTCover{}).
-		Complete(r)
-}
-
-type mqttCoverWrapper struct {
-	*mqttv1alpha1.MQTTCover
-}
-
-func (w *mqttCoverWrapper) GetCommonSpec() *mqttv1alpha1.CommonSpec {
-	return &w.Spec.CommonSpec
-}
-
-func (w *mqttCoverWrapper) GetCommonStatus() *mqttv1alpha1.CommonStatus {
-	return &w.Status.CommonStatus
-}
-
-func (w *mqttCoverWrapper) SetCommonStatus(status mqttv1alpha1.CommonStatus) {
-	w.Status.CommonStatus = status
-}
-
-func (w *mqttCoverWrapper) GetObject() client.Object {
-	return w.MQTTCover
-}
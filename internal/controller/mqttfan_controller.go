@@ -1,182 +0,0 @@
-/*
-Copyright 2026.
-
-Licensed under the Apache License, Version 2.0 (the "License");
-you may not use this file except in compliance with the License.
-You may obtain a copy of the License at
-
-    http://www.apache.org/licenses/LICENSE-2.0
-
-Unless required by applicable law or agreed to in writing, software
-distributed under the License is distributed on an "AS IS" BASIS,
-WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-See the License for the specific language governing permissions and
-limitations under the License.
-*/
-
-package controller
-
-import (
-	"context"
-	"time"
-
-	"github.com/go-logr/logr"
-	"k8s.io/apimachinery/pkg/runtime"
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
-	"github.com/spontus/hass-crds/internal/mqtt"
-	"github.com/spontus/hass-crds/internal/payload"
-)
-
-type MQTTFanReconciler struct {
-	client.Client
-	Scheme     *runtime.Scheme
-	Log        logr.Logger
-	MQTTClient mqtt.Client
-	base       BaseReconciler
-}
-
-func NewMQTTFanReconciler(c client.Client, scheme *runtime.Scheme, log logr.Logger, mqttClient mqtt.Client) *MQTTFanReconciler {
-	return &MQTTFanReconciler{
-		Client:     c,
-		Scheme:     scheme,
-		Log:        log.WithName("mqttfan"),
-		MQTTClient: mqttClient,
-		base: BaseReconciler{
-			Client:     c,
-			Log:        log.WithName("mqttfan"),
-			MQTTClient: mqttClient,
-		},
-	}
-}
-
-// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttfans,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttfans/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttfans/finalizers,verbs=update
-
-func (r *MQTTFanReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	log := r.Log.WithValues("mqttfan", req.NamespacedName)
-
-	var obj mqttv1alpha1.MQTTFan
-	if err := r.Get(ctx, req.NamespacedName, &obj); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
-	wrapper := &mqttFanWrapper{&obj}
-
-	if r.base.IsBeingDeleted(&obj) {
-		if err := r.base.HandleDeletion(ctx, wrapper, "MQTTFan"); err != nil {
-			log.Error(err, "Failed to handle deletion")
-			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
-		}
-		if err := r.base.RemoveFinalizer(ctx, &obj); err != nil {
-			return ctrl.Result{}, err
-		}
-		return ctrl.Result{}, nil
-	}
-
-	if err := r.base.EnsureFinalizer(ctx, &obj); err != nil {
-		return ctrl.Result{}, err
-	}
-
-	if err := r.base.PublishDiscovery(ctx, wrapper, "MQTTFan", r.buildPayload); err != nil {
-		log.Error(err, "Failed to publish discovery")
-		if statusErr := r.base.UpdateStatusFailed(ctx, wrapper, "PublishFailed", err.Error()); statusErr != nil {
-			log.Error(statusErr, "Failed to update status")
-		}
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
-	}
-
-	if err := r.base.UpdateStatusPublished(ctx, wrapper, "MQTTFan"); err != nil {
-		log.Error(err, "Failed to update status")
-		return ctrl.Result{}, err
-	}
-
-	requeueAfter := time.Duration(0)
-	if obj.Spec.RediscoverInterval != "" {
-		d, err := ParseRediscoverInterval(obj.Spec.RediscoverInterval)
-		if err == nil && d > 0 {
-			requeueAfter = d
-		}
-	}
-
-	if requeueAfter > 0 {
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
-	}
-	return ctrl.Result{}, nil
-}
-
-func (r *MQTTFanReconciler) buildPayload(obj EntityObject, uniqueID string) (*payload.Builder, error) {
-	entity := obj.(*mqttFanWrapper).MQTTFan
-	spec := &entity.Spec
-
-	pb := payload.New()
-
-	pb.Set("commandTopic", spec.CommandTopic)
-	pb.Set("name", spec.Name)
-	pb.Set("stateTopic", spec.StateTopic)
-	pb.Set("commandTemplate", spec.CommandTemplate)
-	pb.Set("valueTemplate", spec.ValueTemplate)
-	pb.Set("payloadOn", spec.PayloadOn)
-	pb.Set("payloadOff", spec.PayloadOff)
-	pb.Set("percentageCommandTopic", spec.PercentageCommandTopic)
-	pb.Set("percentageStateTopic", spec.PercentageStateTopic)
-	pb.Set("percentageCommandTemplate", spec.PercentageCommandTemplate)
-	pb.Set("percentageValueTemplate", spec.PercentageValueTemplate)
-	pb.Set("speedRangeMin", spec.SpeedRangeMin)
-	pb.Set("speedRangeMax", spec.SpeedRangeMax)
-	pb.Set("presetModeCommandTopic", spec.PresetModeCommandTopic)
-	pb.Set("presetModeStateTopic", spec.PresetModeStateTopic)
-	pb.Set("presetModeCommandTemplate", spec.PresetModeCommandTemplate)
-	pb.Set("presetModeValueTemplate", spec.PresetModeValueTemplate)
-	pb.Set("presetModes", spec.PresetModes)
-	pb.Set("oscillationCommandTopic", spec.OscillationCommandTopic)
-	pb.Set("oscillationStateTopic", spec.OscillationStateTopic)
-	pb.Set("oscillationCommandTemplate", spec.OscillationCommandTemplate)
-	pb.Set("oscillationValueTemplate", spec.OscillationValueTemplate)
-	pb.Set("payloadOscillationOn", spec.PayloadOscillationOn)
-	pb.Set("payloadOscillationOff", spec.PayloadOscillationOff)
-	pb.Set("directionCommandTopic", spec.DirectionCommandTopic)
-	pb.Set("directionStateTopic", spec.DirectionStateTopic)
-	pb.Set("directionValueTemplate", spec.DirectionValueTemplate)
-	pb.Set("optimistic", spec.Optimistic)
-	pb.Set("icon", spec.Icon)
-	pb.Set("entityCategory", spec.EntityCategory)
-	pb.Set("enabledByDefault", spec.EnabledByDefault)
-	pb.Set("objectId", spec.ObjectId)
-	pb.Set("qos", spec.Qos)
-	pb.Set("retain", spec.Retain)
-	pb.Set("encoding", spec.Encoding)
-	pb.Set("jsonAttributesTopic", spec.JsonAttributesTopic)
-	pb.Set("jsonAttributesTemplate", spec.JsonAttributesTemplate)
-
-	return pb, nil
-}
-
-func (r *MQTTFanReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&mqttv1alpha1.MQTTFan{}).
-		Complete(r)
-}
-
-type mqttFanWrapper struct {
-	*mqttv1alpha1.MQTTFan
-}
-
-func (w *mqttFanWrapper) GetCommonSpec() *mqttv1alpha1.CommonSpec {
-	return &w.Spec.CommonSpec
-}
-
-func (w *mqttFanWrapper) GetCommonStatus() *mqttv1alpha1.CommonStatus {
-	return &w.Status.CommonStatus
-}
-
-func (w *mqttFanWrapper) SetCommonStatus(status mqttv1alpha1.CommonStatus) {
-	w.Status.CommonStatus = status
-}
-
-func (w *mqttFanWrapper) GetObject() client.Object {
-	return w.MQTTFan
-}
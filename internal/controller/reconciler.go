@@ -0,0 +1,264 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/mqtt"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttalarmcontrolpanels,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttalarmcontrolpanels/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttalarmcontrolpanels/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttbinarysensors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttbinarysensors/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttbinarysensors/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttbuttons,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttbuttons/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttbuttons/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcameras,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcameras/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcameras/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttclimates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttclimates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttclimates/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcovers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcovers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttcovers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttdevicetrackers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttdevicetrackers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttdevicetrackers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttdevicetriggers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttdevicetriggers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttdevicetriggers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttentities,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttentities/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttentities/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttevents,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttevents/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttevents/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttfans,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttfans/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttfans/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtthumidifiers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtthumidifiers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtthumidifiers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttimages,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttimages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttimages/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlawnmowers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlawnmowers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlawnmowers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlights,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlights/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlights/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlocks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlocks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttlocks/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttnotifys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttnotifys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttnotifys/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttnumbers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttnumbers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttnumbers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttscenes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttscenes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttscenes/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttselects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttselects/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttselects/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttsensors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttsensors/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttsensors/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttsirens,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttsirens/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttsirens/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttswitches,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttswitches/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttswitches/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtttags,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtttags/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtttags/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtttexts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtttexts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqtttexts/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttupdates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttupdates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttupdates/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttvacuums,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttvacuums/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttvacuums/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttvalves,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttvalves/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttvalves/finalizers,verbs=update
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttwaterheaters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttwaterheaters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttwaterheaters/finalizers,verbs=update
+
+// EntityReconciler reconciles the resources of one entity kind from the registry.
+type EntityReconciler struct {
+	client.Client
+	Log  logr.Logger
+	kind *registry.Kind
+	base BaseReconciler
+}
+
+// NewEntityReconciler creates an EntityReconciler for kind.
+func NewEntityReconciler(c client.Client, kind *registry.Kind, log logr.Logger, mqttClient mqtt.Client) *EntityReconciler {
+	log = log.WithName(strings.ToLower(kind.Kind))
+	return &EntityReconciler{
+		Client: c,
+		Log:    log,
+		kind:   kind,
+		base: BaseReconciler{
+			Client:     c,
+			Log:        log,
+			MQTTClient: mqttClient,
+		},
+	}
+}
+
+func (r *EntityReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues(strings.ToLower(r.kind.Kind), req.NamespacedName)
+
+	obj := r.kind.New().(registry.Entity)
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Check if being deleted
+	if r.base.IsBeingDeleted(obj) {
+		if err := r.base.HandleDeletion(ctx, obj, r.kind); err != nil {
+			log.Error(err, "Failed to handle deletion")
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		}
+		if err := r.base.RemoveFinalizer(ctx, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Ensure finalizer
+	if err := r.base.EnsureFinalizer(ctx, obj); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Merge the defaults that select the entity, recording them in the status
+	merged, defaults, err := r.base.mergeDefaults(ctx, obj, r.kind)
+	if err != nil {
+		log.Error(err, "Failed to merge defaults")
+		if statusErr := r.base.UpdateStatusFailed(ctx, obj, "DefaultsFailed", err.Error()); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+	obj.GetCommonStatus().AppliedDefaults = defaults.names
+
+	// Publish discovery message
+	err = r.base.publishDiscovery(ctx, merged, defaults, r.kind)
+	var invalid *hass.ValidationError
+	if errors.As(err, &invalid) {
+		// Retrying cannot help until the spec changes
+		log.Info("Refusing invalid discovery payload", "problems", invalid.Problems)
+		if statusErr := r.base.UpdateStatusInvalid(ctx, obj, err); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+			return ctrl.Result{}, statusErr
+		}
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		log.Error(err, "Failed to publish discovery")
+		if statusErr := r.base.UpdateStatusFailed(ctx, obj, "PublishFailed", err.Error()); statusErr != nil {
+			log.Error(statusErr, "Failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
+	// Update status
+	if err := r.base.UpdateStatusPublished(ctx, obj, merged, r.kind); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+
+	// Calculate requeue interval, checking back on a queued message until
+	// the outbox has delivered it and the Published condition can turn True
+	var requeueAfter time.Duration
+	if r.base.queued(obj.GetCommonStatus().DiscoveryTopic) {
+		requeueAfter = QueuedRequeueInterval
+	}
+	if d, err := ParseRediscoverInterval(merged.GetCommonSpec().RediscoverInterval); err == nil && d > 0 {
+		if requeueAfter == 0 || d < requeueAfter {
+			requeueAfter = d
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager. Entities are
+// reconciled again when the defaults or the labels of their namespace change.
+func (r *EntityReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	enqueue := handler.EnqueueRequestsFromMapFunc(r.entitiesInNamespace)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.kind.New()).
+		Watches(&mqttv1alpha1.MQTTDefaults{}, enqueue, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&mqttv1alpha1.ClusterMQTTDefaults{}, enqueue, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Namespace{}, enqueue, builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Complete(r)
+}
+
+// entitiesInNamespace returns a request for every entity of the kind in the
+// namespace of obj, or in the namespace obj is. Cluster-scoped defaults have
+// no namespace and select the entities of all namespaces.
+func (r *EntityReconciler) entitiesInNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	namespace := obj.GetNamespace()
+	if _, ok := obj.(*corev1.Namespace); ok {
+		namespace = obj.GetName()
+	}
+
+	list := r.kind.NewList()
+	if err := r.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		r.Log.Error(err, "Failed to list entities to reconcile", "namespace", namespace)
+		return nil
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		r.Log.Error(err, "Failed to list entities to reconcile", "namespace", namespace)
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(items))
+	for _, item := range items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(item.(client.Object))})
+	}
+	return requests
+}
@@ -0,0 +1,548 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/mqtt"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+func TestEntityReconciler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	button := &mqttv1alpha1.MQTTButton{
+		ObjectMeta: metav1.ObjectMeta{Name: "doorbell", Namespace: "default"},
+		Spec: mqttv1alpha1.MQTTButtonSpec{
+			CommonSpec:   mqttv1alpha1.CommonSpec{RediscoverInterval: "1h"},
+			CommandTopic: "doorbell/press",
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(button).
+		WithStatusSubresource(&mqttv1alpha1.MQTTButton{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTButton"), logr.Discard(), mockClient)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(button)}
+	const discoveryTopic = "homeassistant/button/default/doorbell/config"
+
+	result, err := r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	if result.RequeueAfter != time.Hour {
+		t.Errorf("RequeueAfter = %v, want the rediscover interval", result.RequeueAfter)
+	}
+
+	msgs := mockClient.GetPublishedMessages()
+	if len(msgs) != 1 || msgs[0].Topic != discoveryTopic || !msgs[0].Retain {
+		t.Fatalf("published %+v, want one retained config on %s", msgs, discoveryTopic)
+	}
+
+	var got mqttv1alpha1.MQTTButton
+	if err := c.Get(context.Background(), req.NamespacedName, &got); err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	if len(got.Finalizers) != 1 || got.Finalizers[0] != FinalizerName {
+		t.Errorf("Finalizers = %v, want %s", got.Finalizers, FinalizerName)
+	}
+	if got.Status.DiscoveryTopic != discoveryTopic {
+		t.Errorf("DiscoveryTopic = %q, want %q", got.Status.DiscoveryTopic, discoveryTopic)
+	}
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypePublished); cond == nil || cond.Status != mqttv1alpha1.ConditionTrue {
+		t.Errorf("Published condition = %+v, want True", cond)
+	}
+
+	// A failed publish is reported in the status and retried
+	mockClient.SetPublishError(errors.New("broker unavailable"))
+	result, err = r.Reconcile(context.Background(), req)
+	if err == nil || result.RequeueAfter != 30*time.Second {
+		t.Errorf("Reconcile() = %v, %v, want error and requeue", result, err)
+	}
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypePublished); cond == nil || cond.Reason != "PublishFailed" {
+		t.Errorf("Published condition = %+v, want PublishFailed", cond)
+	}
+	mockClient.SetPublishError(nil)
+
+	// Deletion removes the entity from Home Assistant and the finalizer
+	mockClient.ClearMessages()
+	if err := c.Delete(context.Background(), &got); err != nil {
+		t.Fatalf("Delete() error: %v", err)
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	msgs = mockClient.GetPublishedMessages()
+	if len(msgs) != 1 || msgs[0].Topic != discoveryTopic || len(msgs[0].Payload) != 0 {
+		t.Errorf("published %+v, want an empty config on %s", msgs, discoveryTopic)
+	}
+	if err := c.Get(context.Background(), req.NamespacedName, &got); err == nil {
+		t.Error("resource still exists after its finalizer was removed")
+	}
+}
+
+func TestEntityReconciler_Queued(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	button := &mqttv1alpha1.MQTTButton{
+		ObjectMeta: metav1.ObjectMeta{Name: "doorbell", Namespace: "default"},
+		Spec:       mqttv1alpha1.MQTTButtonSpec{CommandTopic: "doorbell/press"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(button).
+		WithStatusSubresource(&mqttv1alpha1.MQTTButton{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	outbox, err := mqtt.NewOutbox(mockClient, &mqtt.Config{}, logr.Discard())
+	if err != nil {
+		t.Fatalf("NewOutbox() error: %v", err)
+	}
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTButton"), logr.Discard(), outbox)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(button)}
+	const discoveryTopic = "homeassistant/button/default/doorbell/config"
+
+	// While the broker is unreachable the message is queued and the entity
+	// is checked again, even without a rediscover interval
+	result, err := r.Reconcile(context.Background(), req)
+	if err != nil || result.RequeueAfter != QueuedRequeueInterval {
+		t.Fatalf("Reconcile() = %v, %v, want requeue after %v", result, err, QueuedRequeueInterval)
+	}
+	var got mqttv1alpha1.MQTTButton
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypePublished); cond == nil || cond.Reason != "Queued" {
+		t.Fatalf("Published condition = %+v, want reason Queued", cond)
+	}
+
+	// Drain the outbox once the broker is back
+	_ = mockClient.Connect(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = outbox.Start(ctx) }()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for outbox.Pending(discoveryTopic) {
+		if time.Now().After(deadline) {
+			t.Fatal("outbox did not drain")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The requeued reconcile reports the delivery and stops requeueing
+	result, err = r.Reconcile(context.Background(), req)
+	if err != nil || result.RequeueAfter != 0 {
+		t.Fatalf("Reconcile() = %v, %v, want no error and no requeue", result, err)
+	}
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypePublished); cond == nil || cond.Status != mqttv1alpha1.ConditionTrue {
+		t.Errorf("Published condition = %+v, want True", cond)
+	}
+}
+
+func TestEntityReconciler_InvalidPayload(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	// A sensor without a state topic is rejected by Home Assistant
+	sensor := &mqttv1alpha1.MQTTSensor{
+		ObjectMeta: metav1.ObjectMeta{Name: "temperature", Namespace: "default"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(sensor).
+		WithStatusSubresource(&mqttv1alpha1.MQTTSensor{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTSensor"), logr.Discard(), mockClient)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(sensor)}
+
+	result, err := r.Reconcile(context.Background(), req)
+	if err != nil || result.RequeueAfter != 0 {
+		t.Fatalf("Reconcile() = %v, %v, want no error and no requeue", result, err)
+	}
+	if msgs := mockClient.GetPublishedMessages(); len(msgs) != 0 {
+		t.Errorf("published %+v, want nothing", msgs)
+	}
+
+	var got mqttv1alpha1.MQTTSensor
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeInvalidPayload)
+	if cond == nil || cond.Status != mqttv1alpha1.ConditionTrue || !strings.Contains(cond.Message, "state_topic is required") {
+		t.Errorf("InvalidPayload condition = %+v, want True naming state_topic", cond)
+	}
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypePublished); cond == nil || cond.Reason != "InvalidPayload" {
+		t.Errorf("Published condition = %+v, want reason InvalidPayload", cond)
+	}
+
+	// Fixing the spec publishes the entity and resolves the condition
+	got.Spec.StateTopic = "sensors/temperature"
+	if err := c.Update(context.Background(), &got); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeInvalidPayload); cond == nil || cond.Status != mqttv1alpha1.ConditionFalse {
+		t.Errorf("InvalidPayload condition = %+v, want False", cond)
+	}
+	if len(mockClient.GetPublishedMessages()) != 1 {
+		t.Errorf("published %d messages, want 1", len(mockClient.GetPublishedMessages()))
+	}
+}
+
+func TestEntityReconciler_IncompatibleClasses(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	sensor := &mqttv1alpha1.MQTTSensor{
+		ObjectMeta: metav1.ObjectMeta{Name: "energy", Namespace: "default"},
+	}
+	sensor.Spec.StateTopic = "meters/energy"
+	sensor.Spec.DeviceClass = "energy"
+	sensor.Spec.UnitOfMeasurement = "W"
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(sensor).
+		WithStatusSubresource(&mqttv1alpha1.MQTTSensor{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTSensor"), logr.Discard(), mockClient)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(sensor)}
+
+	// Incompatible classes are reported but still published
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	if len(mockClient.GetPublishedMessages()) != 1 {
+		t.Errorf("published %d messages, want 1", len(mockClient.GetPublishedMessages()))
+	}
+	var got mqttv1alpha1.MQTTSensor
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeIncompatibleClasses)
+	if cond == nil || cond.Status != mqttv1alpha1.ConditionTrue || !strings.Contains(cond.Message, `unit "W"`) {
+		t.Errorf("IncompatibleClasses condition = %+v, want True naming the unit", cond)
+	}
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypePublished); cond == nil || cond.Status != mqttv1alpha1.ConditionTrue {
+		t.Errorf("Published condition = %+v, want True", cond)
+	}
+
+	// Fixing the unit resolves the condition
+	got.Spec.UnitOfMeasurement = "kWh"
+	if err := c.Update(context.Background(), &got); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeIncompatibleClasses); cond == nil || cond.Status != mqttv1alpha1.ConditionFalse {
+		t.Errorf("IncompatibleClasses condition = %+v, want False", cond)
+	}
+}
+
+func TestEntityReconciler_ExtraConfig(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	light := &mqttv1alpha1.MQTTLight{
+		ObjectMeta: metav1.ObjectMeta{Name: "desk", Namespace: "default"},
+	}
+	light.Spec.CommandTopic = "desk/set"
+	light.Spec.ExtraConfig = &runtime.RawExtension{
+		Raw: []byte(`{"min_kelvin": 2700, "command_topic": "desk/other", "unique_id": "other"}`),
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(light).
+		WithStatusSubresource(&mqttv1alpha1.MQTTLight{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTLight"), logr.Discard(), mockClient)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(light)}
+
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	msgs := mockClient.GetPublishedMessages()
+	if len(msgs) != 1 {
+		t.Fatalf("published %d messages, want 1", len(msgs))
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(msgs[0].Payload, &config); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if config["min_kelvin"] != float64(2700) || config["command_topic"] != "desk/other" {
+		t.Errorf("extraConfig not merged: %v", config)
+	}
+	if config["unique_id"] == "other" {
+		t.Errorf("extraConfig overrode unique_id: %v", config)
+	}
+
+	var got mqttv1alpha1.MQTTLight
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeExtraConfigOverrides)
+	want := "extraConfig overrides typed fields: command_topic; extraConfig keys managed by the controller are ignored: unique_id"
+	if cond == nil || cond.Status != mqttv1alpha1.ConditionTrue || cond.Message != want {
+		t.Errorf("ExtraConfigOverrides condition = %+v, want True with %q", cond, want)
+	}
+
+	// Only setting keys without a typed field resolves the condition
+	got.Spec.ExtraConfig = &runtime.RawExtension{Raw: []byte(`{"min_kelvin": 2700}`)}
+	if err := c.Update(context.Background(), &got); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeExtraConfigOverrides); cond == nil || cond.Status != mqttv1alpha1.ConditionFalse {
+		t.Errorf("ExtraConfigOverrides condition = %+v, want False", cond)
+	}
+}
+
+func TestEntityReconciler_IgnoredFields(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	button := &mqttv1alpha1.MQTTButton{
+		ObjectMeta: metav1.ObjectMeta{Name: "doorbell", Namespace: "default"},
+		Spec: mqttv1alpha1.MQTTButtonSpec{
+			CommonSpec: mqttv1alpha1.CommonSpec{
+				Availability:      []mqttv1alpha1.AvailabilityConfig{{Topic: "doorbell/status"}},
+				AvailabilityTopic: "doorbell/online",
+			},
+			CommandTopic: "doorbell/press",
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(button).
+		WithStatusSubresource(&mqttv1alpha1.MQTTButton{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTButton"), logr.Discard(), mockClient)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(button)}
+
+	// Setting both is a warning, not a refusal: availability wins
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	msgs := mockClient.GetPublishedMessages()
+	if len(msgs) != 1 {
+		t.Fatalf("published %d messages, want 1", len(msgs))
+	}
+	var config map[string]interface{}
+	_ = json.Unmarshal(msgs[0].Payload, &config)
+	if _, ok := config["availability_topic"]; ok || config["availability"] == nil {
+		t.Errorf("payload = %v, want availability without availability_topic", config)
+	}
+
+	var got mqttv1alpha1.MQTTButton
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeIgnoredFields); cond == nil || cond.Status != mqttv1alpha1.ConditionTrue || !strings.Contains(cond.Message, "availabilityTopic") {
+		t.Errorf("IgnoredFields condition = %+v, want True naming availabilityTopic", cond)
+	}
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypePublished); cond == nil || cond.Status != mqttv1alpha1.ConditionTrue {
+		t.Errorf("Published condition = %+v, want True", cond)
+	}
+
+	// Dropping one of them resolves the condition
+	got.Spec.AvailabilityTopic = ""
+	if err := c.Update(context.Background(), &got); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeIgnoredFields); cond == nil || cond.Status != mqttv1alpha1.ConditionFalse {
+		t.Errorf("IgnoredFields condition = %+v, want False", cond)
+	}
+}
+
+func TestEntityReconciler_DeprecatedKeys(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	sensor := &mqttv1alpha1.MQTTSensor{
+		ObjectMeta: metav1.ObjectMeta{Name: "temp", Namespace: "default"},
+	}
+	sensor.Spec.StateTopic = "sensors/temp"
+	sensor.Spec.ObjectId = "living_room_temp"
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(sensor).
+		WithStatusSubresource(&mqttv1alpha1.MQTTSensor{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTSensor"), logr.Discard(), mockClient)
+	r.base.TargetVersion = hass.Version{Year: 2026, Month: 4}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(sensor)}
+
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	msgs := mockClient.GetPublishedMessages()
+	if len(msgs) != 1 {
+		t.Fatalf("published %d messages, want 1", len(msgs))
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(msgs[0].Payload, &config); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if _, ok := config["object_id"]; ok || config["default_entity_id"] != "sensor.living_room_temp" {
+		t.Errorf("object_id not rewritten: %v", config)
+	}
+
+	var got mqttv1alpha1.MQTTSensor
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeDeprecatedKeys)
+	if cond == nil || cond.Status != mqttv1alpha1.ConditionTrue || cond.Reason != "RemovedKeys" {
+		t.Errorf("DeprecatedKeys condition = %+v, want True with reason RemovedKeys", cond)
+	}
+
+	// Without a target the spec is published as it is
+	r.base.TargetVersion = hass.Version{}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	msgs = mockClient.GetPublishedMessages()
+	config = nil
+	if err := json.Unmarshal(msgs[len(msgs)-1].Payload, &config); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if config["object_id"] != "living_room_temp" {
+		t.Errorf("object_id rewritten without a target: %v", config)
+	}
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if cond := findCondition(got.Status.Conditions, mqttv1alpha1.ConditionTypeDeprecatedKeys); cond == nil || cond.Status != mqttv1alpha1.ConditionFalse {
+		t.Errorf("DeprecatedKeys condition = %+v, want False", cond)
+	}
+}
+
+func TestEntityReconciler_Generic(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	entity := &mqttv1alpha1.MQTTEntity{
+		ObjectMeta: metav1.ObjectMeta{Name: "chime", Namespace: "default"},
+	}
+	entity.Spec.Component = "future_platform"
+	entity.Spec.Name = "Chime"
+	entity.Spec.Config = &runtime.RawExtension{
+		Raw: []byte(`{"command_topic": "chime/set", "unique_id": "other"}`),
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(entity).
+		WithStatusSubresource(&mqttv1alpha1.MQTTEntity{}).
+		Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	r := NewEntityReconciler(c, registry.Lookup("MQTTEntity"), logr.Discard(), mockClient)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(entity)}
+
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	msgs := mockClient.GetPublishedMessages()
+	if len(msgs) != 1 {
+		t.Fatalf("published %d messages, want 1", len(msgs))
+	}
+	if want := "homeassistant/future_platform/default/chime/config"; msgs[0].Topic != want {
+		t.Errorf("topic = %q, want %q", msgs[0].Topic, want)
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(msgs[0].Payload, &config); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if config["command_topic"] != "chime/set" || config["name"] != "Chime" {
+		t.Errorf("config not merged with common fields: %v", config)
+	}
+	if config["unique_id"] != "default-chime" || config["origin"] == nil {
+		t.Errorf("unique_id and origin not managed by the controller: %v", config)
+	}
+
+	// A known component validates the config and moves the entity
+	var got mqttv1alpha1.MQTTEntity
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	got.Spec.Component = "switch"
+	if err := c.Update(context.Background(), &got); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile() error: %v", err)
+	}
+	msgs = mockClient.GetPublishedMessages()[1:]
+	if len(msgs) != 2 {
+		t.Fatalf("published %d messages, want 2", len(msgs))
+	}
+	if msgs[0].Topic != "homeassistant/future_platform/default/chime/config" || len(msgs[0].Payload) != 0 {
+		t.Errorf("previous topic not cleared, got %q with %q", msgs[0].Topic, msgs[0].Payload)
+	}
+	if want := "homeassistant/switch/default/chime/config"; msgs[1].Topic != want {
+		t.Errorf("topic = %q, want %q", msgs[1].Topic, want)
+	}
+	_ = c.Get(context.Background(), req.NamespacedName, &got)
+	if got.Status.DiscoveryTopic != "homeassistant/switch/default/chime/config" {
+		t.Errorf("DiscoveryTopic = %q, want the switch topic", got.Status.DiscoveryTopic)
+	}
+}
+
+func findCondition(conditions []mqttv1alpha1.Condition, condType string) *mqttv1alpha1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == condType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
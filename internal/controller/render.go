@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// Rendered is the discovery message built for a resource.
+type Rendered struct {
+	Kind      string
+	Component string
+	Namespace string
+	Name      string
+	Topic     string
+	Payload   []byte
+	QoS       byte
+}
+
+// Render builds the discovery message for obj exactly as the controller for
+// its kind would publish it, resolving deviceRef through r.Client, without
+// publishing anything.
+func (r *BaseReconciler) Render(ctx context.Context, obj client.Object) (*Rendered, error) {
+	entity, kind, err := lookupEntity(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryTopic, data, qos, err := r.BuildDiscovery(ctx, entity, kind)
+	if err != nil {
+		return nil, err
+	}
+	return &Rendered{
+		Kind:      kind.Kind,
+		Component: kind.ComponentOf(entity),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Topic:     discoveryTopic,
+		Payload:   data,
+		QoS:       qos,
+	}, nil
+}
+
+// PublishEntity publishes the discovery message for obj with PublishDiscovery,
+// for callers that handle resources of any kind.
+func (r *BaseReconciler) PublishEntity(ctx context.Context, obj client.Object) error {
+	entity, kind, err := lookupEntity(obj)
+	if err != nil {
+		return err
+	}
+	return r.PublishDiscovery(ctx, entity, kind)
+}
+
+// DeleteEntity removes obj from Home Assistant with HandleDeletion. Only the
+// kind, namespace and name of obj are used, and for an MQTTEntity the topic
+// in its status.
+func (r *BaseReconciler) DeleteEntity(ctx context.Context, obj client.Object) error {
+	entity, kind, err := lookupEntity(obj)
+	if err != nil {
+		return err
+	}
+	return r.HandleDeletion(ctx, entity, kind)
+}
+
+// lookupEntity returns obj as an Entity with its registry kind.
+func lookupEntity(obj client.Object) (registry.Entity, *registry.Kind, error) {
+	name := reflect.TypeOf(obj).Elem().Name()
+	kind := registry.Lookup(name)
+	if !kind.IsEntity() {
+		return nil, nil, fmt.Errorf("%s is not an entity kind", name)
+	}
+	entity, ok := obj.(registry.Entity)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected object type %T for %s", obj, name)
+	}
+	return entity, kind, nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/payload"
+	"github.com/spontus/hass-crds/internal/registry"
+	"github.com/spontus/hass-crds/internal/topic"
+)
+
+func TestRender_AllKinds(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+	r := &BaseReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), Log: logr.Discard()}
+
+	for _, k := range registry.Entities() {
+		if k.IsGeneric() {
+			// Covered by TestEntityReconciler_Generic
+			continue
+		}
+		kind := k.Kind
+		t.Run(kind, func(t *testing.T) {
+			schema := hass.Lookup(k.Component)
+			if schema == nil {
+				t.Fatalf("no discovery schema for %s", k.Component)
+			}
+
+			o := k.New().(registry.Entity)
+			o.SetNamespace("default")
+			o.SetName("test")
+			setRequired(o, schema)
+
+			rendered, err := r.Render(context.Background(), o)
+			if err != nil {
+				t.Fatalf("Render() error: %v", err)
+			}
+			if want := topic.DiscoveryTopic("", kind, "default", "test"); rendered.Topic != want {
+				t.Errorf("Topic = %q, want %q", rendered.Topic, want)
+			}
+
+			var data map[string]interface{}
+			if err := json.Unmarshal(rendered.Payload, &data); err != nil {
+				t.Fatalf("payload is not JSON: %v", err)
+			}
+			if data["origin"] == nil {
+				t.Errorf("payload = %s, want origin", rendered.Payload)
+			}
+			if _, ok := data["unique_id"]; ok != schema.Accepts("unique_id") || ok && data["unique_id"] != "default-test" {
+				t.Errorf("payload = %s, want unique_id only if the component accepts it", rendered.Payload)
+			}
+		})
+	}
+}
+
+// setRequired sets the spec fields of obj for the keys schema requires.
+func setRequired(obj registry.Entity, schema *hass.Component) {
+	required := map[string]bool{}
+	for _, key := range schema.Required {
+		required[key] = true
+	}
+	for _, g := range schema.Exclusive {
+		if g.Required {
+			required[g.Keys[0]] = true
+		}
+	}
+	if required["device"] {
+		obj.GetCommonSpec().Device = &mqttv1alpha1.DeviceBlock{Name: "Test", Identifiers: []string{"test"}}
+	}
+
+	spec := reflect.ValueOf(obj).Elem().FieldByName("Spec")
+	for i := 0; i < spec.NumField(); i++ {
+		key, _ := payload.FieldKey(spec.Type().Field(i))
+		if !required[key] {
+			continue
+		}
+		switch f := spec.Field(i); f.Kind() {
+		case reflect.String:
+			f.SetString("test")
+		case reflect.Slice:
+			f.Set(reflect.ValueOf([]string{"test"}))
+		}
+	}
+}
+
+func TestRender_DeviceRef(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+	device := &mqttv1alpha1.MQTTDevice{
+		ObjectMeta: metav1.ObjectMeta{Name: "garage", Namespace: "default"},
+		Spec:       mqttv1alpha1.MQTTDeviceSpec{Name: "Garage", Identifiers: []string{"garage-1"}},
+	}
+	r := &BaseReconciler{
+		Client:      fake.NewClientBuilder().WithScheme(scheme).WithObjects(device).Build(),
+		Log:         logr.Discard(),
+		ClusterName: "c1",
+	}
+
+	sw := &mqttv1alpha1.MQTTSwitch{
+		ObjectMeta: metav1.ObjectMeta{Name: "door", Namespace: "default"},
+		Spec: mqttv1alpha1.MQTTSwitchSpec{
+			CommonSpec:   mqttv1alpha1.CommonSpec{DeviceRef: &mqttv1alpha1.DeviceRef{Name: "garage"}},
+			CommandTopic: "garage/door/set",
+		},
+	}
+	rendered, err := r.Render(context.Background(), sw)
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	if rendered.Topic != "homeassistant/switch/c1_default/door/config" {
+		t.Errorf("Topic = %q", rendered.Topic)
+	}
+
+	var data struct {
+		Device map[string]interface{} `json:"device"`
+	}
+	_ = json.Unmarshal(rendered.Payload, &data)
+	if data.Device["name"] != "Garage" {
+		t.Errorf("device = %v, want the referenced MQTTDevice", data.Device)
+	}
+
+	// Non-entity kinds cannot be rendered
+	if _, err := r.Render(context.Background(), device); err == nil {
+		t.Error("Render(MQTTDevice) succeeded, want error")
+	}
+}
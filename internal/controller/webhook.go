@@ -0,0 +1,53 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// ValidatePath is where the validating webhook for entities is served.
+const ValidatePath = "/validate-mqtt-home-assistant-io-v1alpha1"
+
+// +kubebuilder:webhook:path=/validate-mqtt-home-assistant-io-v1alpha1,mutating=false,failurePolicy=ignore,sideEffects=None,matchPolicy=Equivalent,groups=mqtt.home-assistant.io,resources=mqttbinarysensors;mqttbuttons;mqttcovers;mqttentities;mqttevents;mqttnumbers;mqttsensors;mqttswitches;mqttupdates,verbs=create;update,versions=v1alpha1,name=validate.mqtt.home-assistant.io,admissionReviewVersions=v1
+
+// entityValidator warns at apply time about the device classes, units and
+// state classes the IncompatibleClasses condition reports after a publish.
+// Home Assistant creates the entity regardless, so nothing is denied. The
+// defaults merged into an entity set none of these fields, so the spec is
+// checked as it is.
+type entityValidator struct {
+	decoder admission.Decoder
+}
+
+// Handle implements admission.Handler.
+func (v *entityValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	kind := registry.Lookup(req.Kind.Kind)
+	if !kind.IsEntity() {
+		return admission.Allowed("")
+	}
+	obj := kind.New().(registry.Entity)
+	if err := v.decoder.DecodeRaw(req.Object, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	return admission.Allowed("").WithWarnings(classProblems(obj, kind)...)
+}
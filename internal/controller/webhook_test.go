@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+func TestEntityValidator(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+	v := &entityValidator{decoder: admission.NewDecoder(scheme)}
+
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		warnings []string
+	}{
+		{
+			name: "compatible sensor",
+			obj: &mqttv1alpha1.MQTTSensor{
+				TypeMeta: metav1.TypeMeta{APIVersion: "mqtt.home-assistant.io/v1alpha1", Kind: "MQTTSensor"},
+				Spec: mqttv1alpha1.MQTTSensorSpec{
+					StateTopic:        "boiler/flow",
+					DeviceClass:       "temperature",
+					UnitOfMeasurement: "°C",
+					StateClass:        "measurement",
+				},
+			},
+		},
+		{
+			name: "unit of another device class",
+			obj: &mqttv1alpha1.MQTTSensor{
+				TypeMeta: metav1.TypeMeta{APIVersion: "mqtt.home-assistant.io/v1alpha1", Kind: "MQTTSensor"},
+				Spec: mqttv1alpha1.MQTTSensorSpec{
+					StateTopic:        "boiler/flow",
+					DeviceClass:       "temperature",
+					UnitOfMeasurement: "C",
+				},
+			},
+			warnings: []string{`unit "C" is not a unit of device class "temperature", use one of °C, °F, K`},
+		},
+		{
+			name: "not an entity",
+			obj: &mqttv1alpha1.MQTTDevice{
+				TypeMeta: metav1.TypeMeta{APIVersion: "mqtt.home-assistant.io/v1alpha1", Kind: "MQTTDevice"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := json.Marshal(tt.obj)
+			if err != nil {
+				t.Fatalf("Marshal() error: %v", err)
+			}
+			gvk := tt.obj.GetObjectKind().GroupVersionKind()
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			}}
+
+			resp := v.Handle(context.Background(), req)
+			if !resp.Allowed {
+				t.Fatalf("Handle() denied the request: %v", resp.Result)
+			}
+			if len(resp.Warnings) != len(tt.warnings) {
+				t.Fatalf("warnings = %q, want %q", resp.Warnings, tt.warnings)
+			}
+			for i := range tt.warnings {
+				if resp.Warnings[i] != tt.warnings[i] {
+					t.Errorf("warning %d = %q, want %q", i, resp.Warnings[i], tt.warnings[i])
+				}
+			}
+		})
+	}
+}
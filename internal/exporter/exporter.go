@@ -0,0 +1,200 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package exporter turns MQTT* resources into Home Assistant's manual `mqtt:`
+// YAML configuration, for installations that run with discovery disabled.
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/controller"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// discoveryOnly lists the platforms Home Assistant only sets up through
+// discovery; they have no YAML configuration.
+var discoveryOnly = map[string]bool{
+	"device_automation": true,
+	"tag":               true,
+}
+
+// discoveryKeys lists the payload keys that are only valid in discovery messages.
+var discoveryKeys = []string{"origin"}
+
+// Entry is the YAML configuration of one entity.
+type Entry struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Platform is the key of the `mqtt:` section the entry belongs to.
+	Platform string                 `json:"platform"`
+	Config   map[string]interface{} `json:"config"`
+}
+
+// Skipped is a resource that has no YAML configuration.
+type Skipped struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Reason    string `json:"reason"`
+}
+
+// Result is the outcome of an export.
+type Result struct {
+	Entries []*Entry  `json:"entries"`
+	Skipped []Skipped `json:"skipped,omitempty"`
+}
+
+// List returns the entity resources in namespace, or in all namespaces if it
+// is empty, that match selector. Kinds are listed in alphabetical order.
+func List(ctx context.Context, c client.Client, namespace string, selector labels.Selector) ([]client.Object, error) {
+	kinds := make([]string, 0, len(registry.Entities()))
+	for _, k := range registry.Entities() {
+		kinds = append(kinds, k.Kind)
+	}
+	sort.Strings(kinds)
+
+	opts := []client.ListOption{client.InNamespace(namespace)}
+	if selector != nil && !selector.Empty() {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
+
+	var objs []client.Object
+	for _, kind := range kinds {
+		obj, err := c.Scheme().New(mqttv1alpha1.GroupVersion.WithKind(kind + "List"))
+		if err != nil {
+			return nil, err
+		}
+		list := obj.(client.ObjectList)
+		if err := c.List(ctx, list, opts...); err != nil {
+			return nil, fmt.Errorf("listing %s: %w", kind, err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			objs = append(objs, item.(client.Object))
+		}
+	}
+	return objs, nil
+}
+
+// Export builds the YAML configuration of objs with the payload assembly the
+// controller uses for discovery, so key names, unique IDs and resolved
+// MQTTDevice blocks match. r resolves deviceRef through its client.
+func Export(ctx context.Context, r *controller.BaseReconciler, objs []client.Object) (*Result, error) {
+	result := &Result{Entries: []*Entry{}}
+	for _, obj := range objs {
+		rendered, err := r.Render(ctx, obj)
+		if err != nil {
+			return nil, fmt.Errorf("%T %s/%s: %w", obj, obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		platform := rendered.Component
+		if discoveryOnly[platform] {
+			result.Skipped = append(result.Skipped, Skipped{
+				Kind:      rendered.Kind,
+				Namespace: rendered.Namespace,
+				Name:      rendered.Name,
+				Reason:    fmt.Sprintf("Home Assistant only supports %s through discovery", platform),
+			})
+			continue
+		}
+
+		var config map[string]interface{}
+		if err := json.Unmarshal(rendered.Payload, &config); err != nil {
+			return nil, fmt.Errorf("decoding payload of %s %s/%s: %w", rendered.Kind, rendered.Namespace, rendered.Name, err)
+		}
+		for _, key := range discoveryKeys {
+			delete(config, key)
+		}
+
+		result.Entries = append(result.Entries, &Entry{
+			Kind:      rendered.Kind,
+			Namespace: rendered.Namespace,
+			Name:      rendered.Name,
+			Platform:  platform,
+			Config:    config,
+		})
+	}
+
+	sort.SliceStable(result.Entries, func(i, j int) bool {
+		a, b := result.Entries[i], result.Entries[j]
+		if a.Platform != b.Platform {
+			return a.Platform < b.Platform
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return result, nil
+}
+
+// WriteYAML writes the entries as an `mqtt:` section grouped by platform,
+// with a comment naming the resource of each entry. Skipped resources are
+// listed as comments at the end.
+func (r *Result) WriteYAML(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("mqtt:")
+	if len(r.Entries) == 0 {
+		b.WriteString(" {}")
+	}
+	b.WriteString("\n")
+
+	platform := ""
+	for _, e := range r.Entries {
+		if e.Platform != platform {
+			platform = e.Platform
+			fmt.Fprintf(&b, "  %s:\n", platform)
+		}
+
+		data, err := yaml.Marshal(e.Config)
+		if err != nil {
+			return fmt.Errorf("encoding %s %s/%s: %w", e.Kind, e.Namespace, e.Name, err)
+		}
+		fmt.Fprintf(&b, "    # %s %s/%s\n", e.Kind, e.Namespace, e.Name)
+		for i, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+			if i == 0 {
+				b.WriteString("    - ")
+			} else {
+				b.WriteString("      ")
+			}
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	for _, s := range r.Skipped {
+		fmt.Fprintf(&b, "# Skipped %s %s/%s: %s\n", s.Kind, s.Namespace, s.Name, s.Reason)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/controller"
+)
+
+func newTestClient(t *testing.T) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	objs := []client.Object{
+		&mqttv1alpha1.MQTTDevice{
+			ObjectMeta: metav1.ObjectMeta{Name: "garage", Namespace: "home"},
+			Spec:       mqttv1alpha1.MQTTDeviceSpec{Name: "Garage", Identifiers: []string{"garage-1"}},
+		},
+		&mqttv1alpha1.MQTTSwitch{
+			ObjectMeta: metav1.ObjectMeta{Name: "door", Namespace: "home", Labels: map[string]string{"site": "garage"}},
+			Spec: mqttv1alpha1.MQTTSwitchSpec{
+				CommonSpec:   mqttv1alpha1.CommonSpec{DeviceRef: &mqttv1alpha1.DeviceRef{Name: "garage"}},
+				CommandTopic: "garage/door/set",
+			},
+		},
+		&mqttv1alpha1.MQTTButton{
+			ObjectMeta: metav1.ObjectMeta{Name: "reboot", Namespace: "home"},
+			Spec:       mqttv1alpha1.MQTTButtonSpec{CommandTopic: "garage/reboot"},
+		},
+		&mqttv1alpha1.MQTTTag{
+			ObjectMeta: metav1.ObjectMeta{Name: "scanner", Namespace: "home"},
+			Spec:       mqttv1alpha1.MQTTTagSpec{Topic: "garage/tag"},
+		},
+		&mqttv1alpha1.MQTTButton{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "lab"},
+			Spec:       mqttv1alpha1.MQTTButtonSpec{CommandTopic: "lab/other"},
+		},
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestList(t *testing.T) {
+	c := newTestClient(t)
+
+	tests := []struct {
+		name      string
+		namespace string
+		selector  string
+		want      int
+	}{
+		{"namespace", "home", "", 3},
+		{"all namespaces", "", "", 4},
+		{"selector", "home", "site=garage", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, _ := labels.Parse(tt.selector)
+			objs, err := List(context.Background(), c, tt.namespace, selector)
+			if err != nil {
+				t.Fatalf("List() error: %v", err)
+			}
+			if len(objs) != tt.want {
+				t.Errorf("List() returned %d objects, want %d", len(objs), tt.want)
+			}
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	c := newTestClient(t)
+	objs, err := List(context.Background(), c, "home", labels.Everything())
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+
+	base := &controller.BaseReconciler{Client: c, Log: logr.Discard(), ClusterName: "c1", InstanceID: "c1"}
+	result, err := Export(context.Background(), base, objs)
+	if err != nil {
+		t.Fatalf("Export() error: %v", err)
+	}
+
+	if len(result.Entries) != 2 || result.Entries[0].Platform != "button" || result.Entries[1].Platform != "switch" {
+		t.Fatalf("unexpected entries %+v", result.Entries)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].Kind != "MQTTTag" {
+		t.Errorf("Skipped = %+v, want the tag", result.Skipped)
+	}
+
+	door := result.Entries[1].Config
+	if _, ok := door["origin"]; ok {
+		t.Error("config contains the discovery-only origin block")
+	}
+	if door["unique_id"] != "c1_home-door" || door["command_topic"] != "garage/door/set" {
+		t.Errorf("unexpected config %v", door)
+	}
+	if device, _ := door["device"].(map[string]interface{}); device["name"] != "Garage" {
+		t.Errorf("device = %v, want the resolved MQTTDevice", door["device"])
+	}
+
+	var buf bytes.Buffer
+	if err := result.WriteYAML(&buf); err != nil {
+		t.Fatalf("WriteYAML() error: %v", err)
+	}
+	want := `mqtt:
+  button:
+    # MQTTButton home/reboot
+    - command_topic: garage/reboot
+      unique_id: c1_home-reboot
+  switch:
+    # MQTTSwitch home/door
+    - command_topic: garage/door/set
+      device:
+        identifiers:
+        - garage-1
+        name: Garage
+      unique_id: c1_home-door
+# Skipped MQTTTag home/scanner: Home Assistant only supports tag through discovery
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteYAML() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExport_Empty(t *testing.T) {
+	result, err := Export(context.Background(), &controller.BaseReconciler{Log: logr.Discard()}, nil)
+	if err != nil {
+		t.Fatalf("Export() error: %v", err)
+	}
+	var buf bytes.Buffer
+	_ = result.WriteYAML(&buf)
+	if !strings.HasPrefix(buf.String(), "mqtt: {}") {
+		t.Errorf("WriteYAML() = %q, want an empty mqtt section", buf.String())
+	}
+}
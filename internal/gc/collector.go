@@ -20,17 +20,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/metrics"
 	"github.com/spontus/hass-crds/internal/mqtt"
 	"github.com/spontus/hass-crds/internal/payload"
+	"github.com/spontus/hass-crds/internal/registry"
 	"github.com/spontus/hass-crds/internal/topic"
 )
 
@@ -40,8 +49,48 @@ type Config struct {
 	Interval       time.Duration
 	RunOnStartup   bool
 	SilenceTimeout time.Duration
+	// StaleCycles is how many intervals may pass without a successful cycle
+	// before the health check fails.
+	StaleCycles int
+	// DryRun reports orphans without removing them. The spec of the
+	// MQTTGarbageCollection resource overrides it.
+	DryRun bool
+	// ReportName is the name of the cluster-scoped MQTTGarbageCollection
+	// resource that receives cycle reports and manual triggers. It defaults
+	// to DefaultReportName, prefixed with InstanceID if set.
+	ReportName string
+	// InstanceID restricts collection to payloads whose origin is this
+	// controller instance.
+	InstanceID string
+	// Selector restricts the resources listed for expected topics to those
+	// owned by this instance. Nil matches everything.
+	Selector labels.Selector
+	// ClusterName is folded into the expected discovery topics, as by the
+	// entity controllers.
+	ClusterName string
+	// GraceCycles is how many consecutive cycles must find an orphan before
+	// it is removed.
+	GraceCycles int
+	// MaxDeletions caps the orphans removed per cycle. A cycle that would
+	// remove more removes nothing. 0 disables the cap.
+	MaxDeletions int
+	// MaxDeletionPercent caps the orphans removed per cycle as a percentage
+	// of the entities with our origin on the broker. 0 disables the cap.
+	MaxDeletionPercent int
 }
 
+const (
+	// startupDelay is how long Start waits for caches to sync before the first cycle.
+	startupDelay = 10 * time.Second
+
+	// DefaultReportName is the default name of the MQTTGarbageCollection resource.
+	DefaultReportName = "hass-crds"
+
+	// maxReportedOrphans caps the orphans listed in a run report to keep the
+	// resource well below the etcd object size limit.
+	maxReportedOrphans = 500
+)
+
 // NewConfigFromEnv creates a Config from environment variables.
 func NewConfigFromEnv() Config {
 	cfg := Config{
@@ -49,6 +98,9 @@ func NewConfigFromEnv() Config {
 		Interval:       5 * time.Minute,
 		RunOnStartup:   true,
 		SilenceTimeout: 5 * time.Second,
+		StaleCycles:    3,
+		GraceCycles:    3,
+		MaxDeletions:   25,
 	}
 
 	if v := os.Getenv("GC_ENABLED"); v == "false" {
@@ -71,9 +123,44 @@ func NewConfigFromEnv() Config {
 		}
 	}
 
+	if v := os.Getenv("GC_STALE_CYCLES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.StaleCycles = n
+		}
+	}
+
+	if v := os.Getenv("GC_DRY_RUN"); v == "true" {
+		cfg.DryRun = true
+	}
+
+	if v := os.Getenv("GC_REPORT_NAME"); v != "" {
+		cfg.ReportName = v
+	}
+
+	if v := os.Getenv("GC_GRACE_CYCLES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cfg.GraceCycles = n
+		}
+	}
+
+	if v := os.Getenv("GC_MAX_DELETIONS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.MaxDeletions = n
+		}
+	}
+
+	if v := os.Getenv("GC_MAX_DELETION_PERCENT"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 && n <= 100 {
+			cfg.MaxDeletionPercent = n
+		}
+	}
+
 	return cfg
 }
 
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttdevices,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
 // OrphanCollector detects and removes orphaned MQTT discovery entities
 // that have no corresponding Kubernetes Custom Resource.
 type OrphanCollector struct {
@@ -81,18 +168,48 @@ type OrphanCollector struct {
 	mqttClient mqtt.Client
 	log        logr.Logger
 	config     Config
+	originName string
+
+	mu          sync.Mutex
+	started     time.Time
+	lastSuccess time.Time
+	lastRun     *mqttv1alpha1.GarbageCollectionRun
+	trigger     string
+
+	// seen counts the consecutive cycles each orphan has been found in.
+	// It is only used by the collection loop.
+	seen map[string]int
+
+	recorder record.EventRecorder
+	wake     chan struct{}
 }
 
-// NewOrphanCollector creates a new OrphanCollector.
-func NewOrphanCollector(k8sClient client.Client, mqttClient mqtt.Client, log logr.Logger, config Config) *OrphanCollector {
+// NewOrphanCollector creates a new OrphanCollector. recorder receives a warning
+// event on the MQTTGarbageCollection resource when a cycle exceeds the deletion cap.
+func NewOrphanCollector(k8sClient client.Client, mqttClient mqtt.Client, recorder record.EventRecorder, log logr.Logger, config Config) *OrphanCollector {
+	if config.ReportName == "" {
+		config.ReportName = DefaultReportName
+		if config.InstanceID != "" {
+			config.ReportName = config.InstanceID + "-" + DefaultReportName
+		}
+	}
+
 	return &OrphanCollector{
 		k8sClient:  k8sClient,
 		mqttClient: mqttClient,
+		recorder:   recorder,
 		log:        log.WithName("gc"),
 		config:     config,
+		originName: payload.OriginNameFor(config.InstanceID),
+		wake:       make(chan struct{}, 1),
 	}
 }
 
+// ReportName returns the name of the MQTTGarbageCollection resource.
+func (c *OrphanCollector) ReportName() string {
+	return c.config.ReportName
+}
+
 // Start implements manager.Runnable. It runs the garbage collection loop.
 func (c *OrphanCollector) Start(ctx context.Context) error {
 	if !c.config.Enabled {
@@ -104,17 +221,26 @@ func (c *OrphanCollector) Start(ctx context.Context) error {
 		"interval", c.config.Interval,
 		"runOnStartup", c.config.RunOnStartup,
 		"silenceTimeout", c.config.SilenceTimeout,
+		"dryRun", c.config.DryRun,
+		"origin", c.originName,
+		"graceCycles", c.config.GraceCycles,
+		"maxDeletions", c.config.MaxDeletions,
+		"maxDeletionPercent", c.config.MaxDeletionPercent,
 	)
 
+	c.mu.Lock()
+	c.started = time.Now()
+	c.mu.Unlock()
+
 	// Wait for caches to sync before first run
 	select {
 	case <-ctx.Done():
 		return nil
-	case <-time.After(10 * time.Second):
+	case <-time.After(startupDelay):
 	}
 
 	if c.config.RunOnStartup {
-		if err := c.Collect(ctx); err != nil {
+		if err := c.run(ctx, mqttv1alpha1.GarbageCollectionTriggerStartup, ""); err != nil {
 			c.log.Error(err, "Initial garbage collection failed")
 		}
 	}
@@ -131,18 +257,170 @@ func (c *OrphanCollector) Start(ctx context.Context) error {
 			if err := c.Collect(ctx); err != nil {
 				c.log.Error(err, "Garbage collection cycle failed")
 			}
+		case <-c.wake:
+			c.mu.Lock()
+			trigger := c.trigger
+			c.mu.Unlock()
+
+			c.log.Info("Running garbage collection on demand", "trigger", trigger)
+			if err := c.run(ctx, mqttv1alpha1.GarbageCollectionTriggerManual, trigger); err != nil {
+				c.log.Error(err, "Manual garbage collection cycle failed")
+			}
 		}
 	}
 }
 
-// discoveredEntity represents an entity found via MQTT discovery.
-type discoveredEntity struct {
+// Trigger requests a garbage collection cycle outside the schedule. value is
+// the gc-trigger annotation that requested it and is recorded as observed once
+// the cycle completes. Triggers arriving while a cycle runs are coalesced.
+func (c *OrphanCollector) Trigger(value string) {
+	c.mu.Lock()
+	c.trigger = value
+	c.mu.Unlock()
+
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// LastRun returns the report of the most recent cycle, or nil if none has run.
+func (c *OrphanCollector) LastRun() *mqttv1alpha1.GarbageCollectionRun {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastRun
+}
+
+// DiscoveredEntity is a retained discovery config found on the broker.
+type DiscoveredEntity struct {
 	Topic   string
 	Payload []byte
 }
 
-// Collect runs a single garbage collection cycle.
+// Collect runs a single scheduled garbage collection cycle, records its
+// metrics and writes its report to the MQTTGarbageCollection resource.
 func (c *OrphanCollector) Collect(ctx context.Context) error {
+	return c.run(ctx, mqttv1alpha1.GarbageCollectionTriggerScheduled, "")
+}
+
+// run executes a cycle started by trigger. observedTrigger is the gc-trigger
+// annotation value that requested a manual cycle, if any.
+func (c *OrphanCollector) run(ctx context.Context, trigger, observedTrigger string) error {
+	report := &mqttv1alpha1.GarbageCollectionRun{
+		StartTime: metav1.Now(),
+		Trigger:   trigger,
+		DryRun:    c.dryRun(ctx),
+	}
+
+	err := c.collect(ctx, report)
+
+	completed := metav1.Now()
+	report.CompletionTime = &completed
+	switch {
+	case err != nil:
+		report.Result = mqttv1alpha1.GarbageCollectionFailed
+		report.Message = err.Error()
+		metrics.GCCyclesTotal.WithLabelValues(metrics.ResultError).Inc()
+	case report.Result == mqttv1alpha1.GarbageCollectionRefused:
+		// The cycle worked; refusing is the safety net doing its job
+		metrics.GCCyclesTotal.WithLabelValues(metrics.ResultRefused).Inc()
+		metrics.GCLastSuccess.SetToCurrentTime()
+	default:
+		report.Result = mqttv1alpha1.GarbageCollectionSucceeded
+		metrics.GCCyclesTotal.WithLabelValues(metrics.ResultSuccess).Inc()
+		metrics.GCLastSuccess.SetToCurrentTime()
+	}
+
+	c.mu.Lock()
+	c.lastRun = report
+	if err == nil {
+		c.lastSuccess = completed.Time
+	}
+	c.mu.Unlock()
+
+	if ctx.Err() != nil {
+		return err
+	}
+	gc := c.writeReport(ctx, report, observedTrigger)
+	if gc != nil && report.Result == mqttv1alpha1.GarbageCollectionRefused {
+		c.recorder.Event(gc, corev1.EventTypeWarning, "DeletionCapExceeded", report.Message)
+	}
+	return err
+}
+
+// dryRun returns spec.dryRun of the MQTTGarbageCollection resource if set,
+// and Config.DryRun otherwise.
+func (c *OrphanCollector) dryRun(ctx context.Context) bool {
+	gc := &mqttv1alpha1.MQTTGarbageCollection{}
+	if err := c.k8sClient.Get(ctx, client.ObjectKey{Name: c.config.ReportName}, gc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			c.log.V(1).Info("Failed to get MQTTGarbageCollection, using configured dry run", "error", err.Error())
+		}
+		return c.config.DryRun
+	}
+	if gc.Spec.DryRun != nil {
+		return *gc.Spec.DryRun
+	}
+	return c.config.DryRun
+}
+
+// writeReport records report as the last run of the MQTTGarbageCollection
+// resource, creating the resource if it does not exist, and returns the
+// resource. Failures are logged and return nil; the report is still served
+// from memory by LastRun.
+func (c *OrphanCollector) writeReport(ctx context.Context, report *mqttv1alpha1.GarbageCollectionRun, observedTrigger string) *mqttv1alpha1.MQTTGarbageCollection {
+	gc := &mqttv1alpha1.MQTTGarbageCollection{}
+	err := c.k8sClient.Get(ctx, client.ObjectKey{Name: c.config.ReportName}, gc)
+	if apierrors.IsNotFound(err) {
+		gc = &mqttv1alpha1.MQTTGarbageCollection{
+			ObjectMeta: metav1.ObjectMeta{Name: c.config.ReportName},
+		}
+		err = c.k8sClient.Create(ctx, gc)
+	}
+	if err != nil {
+		c.log.Error(err, "Failed to get MQTTGarbageCollection for report", "name", c.config.ReportName)
+		return nil
+	}
+
+	gc.Status.LastRun = report
+	if observedTrigger != "" {
+		gc.Status.ObservedTrigger = observedTrigger
+	}
+	if err := c.k8sClient.Status().Update(ctx, gc); err != nil {
+		c.log.Error(err, "Failed to update MQTTGarbageCollection status", "name", c.config.ReportName)
+	}
+	return gc
+}
+
+// HealthCheck implements healthz.Checker. It fails when no cycle has succeeded
+// for Config.StaleCycles intervals. It passes while the collector is disabled
+// or not running, e.g. on a replica that is not the leader.
+func (c *OrphanCollector) HealthCheck(_ *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.config.Enabled || c.started.IsZero() {
+		return nil
+	}
+
+	last := c.lastSuccess
+	if last.IsZero() {
+		// Give the first cycle until the startup delay plus the allowed intervals
+		last = c.started.Add(startupDelay)
+	}
+
+	stale := c.config.Interval * time.Duration(max(c.config.StaleCycles, 1))
+	if since := time.Since(last); since > stale {
+		if c.lastSuccess.IsZero() {
+			return fmt.Errorf("no successful garbage collection cycle since start %s ago", time.Since(c.started).Round(time.Second))
+		}
+		return fmt.Errorf("last successful garbage collection cycle was %s ago", since.Round(time.Second))
+	}
+	return nil
+}
+
+// collect runs the cycle, filling in the counts and orphans of report.
+func (c *OrphanCollector) collect(ctx context.Context, report *mqttv1alpha1.GarbageCollectionRun) error {
 	c.log.V(1).Info("Starting garbage collection cycle")
 
 	// Step 1: Subscribe and collect retained discovery messages
@@ -152,13 +430,15 @@ func (c *OrphanCollector) Collect(ctx context.Context) error {
 	}
 
 	// Step 2: Filter to only entities we created (origin.name == "hass-crds")
-	ours := filterOurEntities(entities)
+	ours := filterOurEntities(entities, c.originName)
 	if len(ours) == 0 {
+		c.observe(nil)
 		c.log.V(1).Info("No entities with our origin found")
 		return nil
 	}
 
 	c.log.V(1).Info("Found entities with our origin", "count", len(ours))
+	report.Scanned = len(ours)
 
 	// Step 3: Build set of expected discovery topics from existing CRs,
 	// also tracking which component types were successfully listed.
@@ -167,34 +447,237 @@ func (c *OrphanCollector) Collect(ctx context.Context) error {
 	// Step 4: Find orphans — only for components we successfully listed.
 	// If we failed to list a component type, we must not treat its entities as orphans.
 	orphans := findOrphans(ours, expected, verifiedComponents)
+	seen := c.observe(orphans)
 	if len(orphans) == 0 {
 		c.log.V(1).Info("No orphaned entities found")
 		return nil
 	}
 
-	c.log.Info("Found orphaned entities", "count", len(orphans))
+	c.log.Info("Found orphaned entities", "count", len(orphans), "dryRun", report.DryRun)
+	metrics.GCOrphansFoundTotal.Add(float64(len(orphans)))
+	report.OrphanCount = len(orphans)
+
+	protected, err := c.protectedDevices(ctx)
+	if err != nil {
+		return fmt.Errorf("listing protected devices: %w", err)
+	}
+
+	payloads := make(map[string][]byte, len(ours))
+	for _, e := range ours {
+		payloads[e.Topic] = e.Payload
+	}
 
-	// Step 5: Publish empty payloads to remove orphans
+	// Step 5: Hold back orphans that are protected or still within the grace period
+	reports := make([]mqttv1alpha1.OrphanReport, 0, len(orphans))
+	var removable []int
+	pending := 0
 	for _, orphanTopic := range orphans {
-		c.log.Info("Removing orphaned entity", "topic", orphanTopic)
-		if err := c.mqttClient.Publish(ctx, orphanTopic, []byte{}, 1, true); err != nil {
-			c.log.Error(err, "Failed to remove orphaned entity", "topic", orphanTopic)
+		orphan := newOrphanReport(orphanTopic, payloads[orphanTopic])
+		orphan.ObservedCycles = seen[orphanTopic]
+
+		switch {
+		case protected.matches(payloads[orphanTopic]):
+			c.log.V(1).Info("Skipping orphaned entity of protected device", "topic", orphanTopic)
+			orphan.Action = mqttv1alpha1.OrphanActionProtected
+		case orphan.ObservedCycles < c.config.GraceCycles:
+			c.log.V(1).Info("Orphaned entity within grace period", "topic", orphanTopic,
+				"observedCycles", orphan.ObservedCycles, "graceCycles", c.config.GraceCycles)
+			orphan.Action = mqttv1alpha1.OrphanActionPending
+			pending++
+		default:
+			removable = append(removable, len(reports))
 		}
+		reports = append(reports, orphan)
+	}
+	metrics.GCOrphansPending.Set(float64(pending))
+
+	// Step 6: Refuse to remove anything if the deletions exceed the cap
+	if err := c.config.checkDeletionCap(len(removable), len(ours)); err != nil {
+		c.log.Error(err, "Refusing to remove orphaned entities", "orphans", len(removable), "scanned", len(ours))
+		metrics.GCDeletionsRefusedTotal.Inc()
+		report.Result = mqttv1alpha1.GarbageCollectionRefused
+		report.Message = err.Error()
+		for _, i := range removable {
+			reports[i].Action = mqttv1alpha1.OrphanActionRefused
+		}
+		removable = nil
 	}
 
+	// Step 7: Publish empty payloads to remove orphans
+	for _, i := range removable {
+		orphan := &reports[i]
+		if report.DryRun {
+			c.log.Info("Would remove orphaned entity", "topic", orphan.Topic)
+			orphan.Action = mqttv1alpha1.OrphanActionWouldRemove
+		} else if err := c.removeOrphan(ctx, orphan.Topic); err != nil {
+			c.log.Error(err, "Failed to remove orphaned entity", "topic", orphan.Topic)
+			orphan.Action = mqttv1alpha1.OrphanActionRemoveFailed
+			orphan.Error = err.Error()
+		} else {
+			orphan.Action = mqttv1alpha1.OrphanActionRemoved
+			metrics.GCOrphansRemovedTotal.Inc()
+		}
+	}
+
+	report.Orphans = reports[:min(len(reports), maxReportedOrphans)]
 	return nil
 }
 
+// observe records orphans as seen in this cycle and returns how many
+// consecutive cycles each has been seen. Orphans missing from this cycle
+// start over.
+func (c *OrphanCollector) observe(orphans []string) map[string]int {
+	seen := make(map[string]int, len(orphans))
+	for _, t := range orphans {
+		seen[t] = c.seen[t] + 1
+	}
+	c.seen = seen
+	if len(orphans) == 0 {
+		metrics.GCOrphansPending.Set(0)
+	}
+	return seen
+}
+
+// checkDeletionCap returns an error if removing deletions of the scanned
+// entities exceeds MaxDeletions or MaxDeletionPercent.
+func (cfg Config) checkDeletionCap(deletions, scanned int) error {
+	if cfg.MaxDeletions > 0 && deletions > cfg.MaxDeletions {
+		return fmt.Errorf("%d orphans exceed the deletion cap of %d per cycle", deletions, cfg.MaxDeletions)
+	}
+	if cfg.MaxDeletionPercent > 0 && scanned > 0 && deletions*100 > cfg.MaxDeletionPercent*scanned {
+		return fmt.Errorf("%d orphans are %d%% of %d entities, exceeding the deletion cap of %d%%",
+			deletions, deletions*100/scanned, scanned, cfg.MaxDeletionPercent)
+	}
+	return nil
+}
+
+// deviceSet holds the identifiers and connections of protected devices.
+type deviceSet struct {
+	identifiers map[string]struct{}
+	connections map[[2]string]struct{}
+}
+
+// protectedDevices returns the devices of all MQTTDevices carrying the
+// gc-protect annotation or label.
+func (c *OrphanCollector) protectedDevices(ctx context.Context) (deviceSet, error) {
+	set := deviceSet{
+		identifiers: map[string]struct{}{},
+		connections: map[[2]string]struct{}{},
+	}
+
+	var devices mqttv1alpha1.MQTTDeviceList
+	if err := c.k8sClient.List(ctx, &devices); err != nil {
+		return set, err
+	}
+
+	for _, d := range devices.Items {
+		if d.Annotations[mqttv1alpha1.GarbageCollectionProtectAnnotation] != "true" &&
+			d.Labels[mqttv1alpha1.GarbageCollectionProtectAnnotation] != "true" {
+			continue
+		}
+		for _, id := range d.Spec.Identifiers {
+			set.identifiers[id] = struct{}{}
+		}
+		for _, conn := range d.Spec.Connections {
+			if len(conn) == 2 {
+				set.connections[[2]string{conn[0], conn[1]}] = struct{}{}
+			}
+		}
+	}
+	return set, nil
+}
+
+// matches reports whether the device block of a discovery payload belongs to
+// a protected device. identifiers may be a string or a list, as in Home Assistant.
+func (s deviceSet) matches(data []byte) bool {
+	if len(s.identifiers) == 0 && len(s.connections) == 0 {
+		return false
+	}
+
+	var p struct {
+		Device struct {
+			Identifiers any        `json:"identifiers"`
+			Connections [][]string `json:"connections"`
+		} `json:"device"`
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return false
+	}
+
+	var ids []any
+	switch v := p.Device.Identifiers.(type) {
+	case string:
+		ids = []any{v}
+	case []any:
+		ids = v
+	}
+	for _, raw := range ids {
+		if id, ok := raw.(string); ok {
+			if _, found := s.identifiers[id]; found {
+				return true
+			}
+		}
+	}
+	for _, conn := range p.Device.Connections {
+		if len(conn) == 2 {
+			if _, found := s.connections[[2]string{conn[0], conn[1]}]; found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// removeOrphan clears the retained discovery config at orphanTopic.
+func (c *OrphanCollector) removeOrphan(ctx context.Context, orphanTopic string) error {
+	c.log.Info("Removing orphaned entity", "topic", orphanTopic)
+	return c.mqttClient.Publish(ctx, orphanTopic, []byte{}, 1, true)
+}
+
+// newOrphanReport describes the orphan at orphanTopic using its discovery payload.
+func newOrphanReport(orphanTopic string, data []byte) mqttv1alpha1.OrphanReport {
+	report := mqttv1alpha1.OrphanReport{Topic: orphanTopic}
+	if info, err := topic.ParseDiscoveryTopic(orphanTopic); err == nil {
+		report.Component = info.Component
+	}
+
+	var p struct {
+		Origin struct {
+			Name      string `json:"name"`
+			SwVersion string `json:"sw_version"`
+		} `json:"origin"`
+	}
+	if err := json.Unmarshal(data, &p); err == nil {
+		report.OriginName = p.Origin.Name
+		report.OriginSwVersion = p.Origin.SwVersion
+	}
+	return report
+}
+
 // collectDiscoveryMessages subscribes to discovery topics and collects retained messages.
-func (c *OrphanCollector) collectDiscoveryMessages(ctx context.Context) ([]discoveredEntity, error) {
+func (c *OrphanCollector) collectDiscoveryMessages(ctx context.Context) ([]DiscoveredEntity, error) {
+	return ScanDiscovery(ctx, c.mqttClient, c.config.SilenceTimeout)
+}
+
+// scanMu serializes scans: concurrent scans on one client would share the
+// subscription, and the first to finish would unsubscribe the others.
+var scanMu sync.Mutex
+
+// ScanDiscovery subscribes to the discovery config topics and returns the
+// retained messages the broker sends, including empty ones. The scan ends
+// once no new message has arrived for silence.
+func ScanDiscovery(ctx context.Context, mqttClient mqtt.Client, silence time.Duration) ([]DiscoveredEntity, error) {
+	scanMu.Lock()
+	defer scanMu.Unlock()
+
 	var mu sync.Mutex
-	var entities []discoveredEntity
+	var entities []DiscoveredEntity
 
 	subscriptionTopic := topic.DefaultDiscoveryPrefix + "/+/+/+/config"
 
-	err := c.mqttClient.Subscribe(ctx, subscriptionTopic, 0, func(t string, p []byte) {
+	err := mqttClient.Subscribe(ctx, subscriptionTopic, 0, func(t string, p []byte) {
 		mu.Lock()
-		entities = append(entities, discoveredEntity{Topic: t, Payload: p})
+		entities = append(entities, DiscoveredEntity{Topic: t, Payload: p})
 		mu.Unlock()
 	})
 	if err != nil {
@@ -203,13 +686,13 @@ func (c *OrphanCollector) collectDiscoveryMessages(ctx context.Context) ([]disco
 
 	// Wait for retained messages to arrive. Reset timer on each new message.
 	lastCount := -1
-	timer := time.NewTimer(c.config.SilenceTimeout)
+	timer := time.NewTimer(silence)
 	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			_ = c.mqttClient.Unsubscribe(ctx, subscriptionTopic)
+			_ = mqttClient.Unsubscribe(ctx, subscriptionTopic)
 			return nil, ctx.Err()
 		case <-timer.C:
 			mu.Lock()
@@ -218,33 +701,34 @@ func (c *OrphanCollector) collectDiscoveryMessages(ctx context.Context) ([]disco
 
 			if currentCount == lastCount || lastCount == -1 && currentCount == 0 {
 				// No new messages arrived during the silence window
-				_ = c.mqttClient.Unsubscribe(context.Background(), subscriptionTopic)
+				_ = mqttClient.Unsubscribe(context.Background(), subscriptionTopic)
 				return entities, nil
 			}
 
 			// New messages arrived, reset timer
 			lastCount = currentCount
-			timer.Reset(c.config.SilenceTimeout)
+			timer.Reset(silence)
 		}
 	}
 }
 
-// filterOurEntities returns only entities whose payload has origin.name == "hass-crds".
-func filterOurEntities(entities []discoveredEntity) []discoveredEntity {
-	var result []discoveredEntity
+// filterOurEntities returns only entities whose payload has origin.name == originName.
+func filterOurEntities(entities []DiscoveredEntity, originName string) []DiscoveredEntity {
+	var result []DiscoveredEntity
 	for _, e := range entities {
 		if len(e.Payload) == 0 {
 			continue
 		}
-		if hasOurOrigin(e.Payload) {
+		if hasOurOrigin(e.Payload, originName) {
 			result = append(result, e)
 		}
 	}
 	return result
 }
 
-// hasOurOrigin checks if a JSON payload has origin.name matching our origin name.
-func hasOurOrigin(data []byte) bool {
+// hasOurOrigin checks if a JSON payload has origin.name matching originName.
+// Payloads of other controller instances have a different origin name.
+func hasOurOrigin(data []byte, originName string) bool {
 	var p map[string]interface{}
 	if err := json.Unmarshal(data, &p); err != nil {
 		return false
@@ -261,49 +745,72 @@ func hasOurOrigin(data []byte) bool {
 	}
 
 	name, ok := originMap["name"].(string)
-	return ok && name == payload.OriginName
+	return ok && name == originName
 }
 
+// anyComponent is set in the verified components once MQTTEntity resources
+// were listed, verifying the components no typed kind is published as.
+const anyComponent = "*"
+
 // buildExpectedTopics lists all CRs and returns:
 // - the set of discovery topics that should exist
 // - the set of HA component types that were successfully listed
+//
+// MQTTEntity resources may be published as any component, so if they cannot
+// be listed no component is verified.
 func (c *OrphanCollector) buildExpectedTopics(ctx context.Context) (map[string]struct{}, map[string]struct{}) {
 	expected := make(map[string]struct{})
 	verifiedComponents := make(map[string]struct{})
+	genericListed := true
 
-	for kind, component := range topic.ComponentMapping {
+	for _, k := range registry.Entities() {
+		kind, component := k.Kind, k.Component
 		list := &unstructured.UnstructuredList{}
-		list.SetGroupVersionKind(schema.GroupVersionKind{
-			Group:   "mqtt.home-assistant.io",
-			Version: "v1alpha1",
-			Kind:    kind + "List",
-		})
+		list.SetGroupVersionKind(mqttv1alpha1.GroupVersion.WithKind(kind + "List"))
 
-		if err := c.k8sClient.List(ctx, list); err != nil {
+		var opts []client.ListOption
+		if c.config.Selector != nil {
+			opts = append(opts, client.MatchingLabelsSelector{Selector: c.config.Selector})
+		}
+		if err := c.k8sClient.List(ctx, list, opts...); err != nil {
 			c.log.Info("Failed to list CRs, will not GC this type", "kind", kind, "error", err)
+			if k.IsGeneric() {
+				genericListed = false
+			}
 			continue
 		}
 
+		if k.IsGeneric() {
+			component = anyComponent
+		}
 		verifiedComponents[component] = struct{}{}
 
 		for _, item := range list.Items {
-			t := fmt.Sprintf("%s/%s/%s/%s/config",
-				topic.DefaultDiscoveryPrefix,
-				component,
-				item.GetNamespace(),
-				item.GetName(),
-			)
+			if k.IsGeneric() {
+				component, _, _ := unstructured.NestedString(item.Object, "spec", "component")
+				if component == "" {
+					continue
+				}
+				t := topic.ComponentDiscoveryTopic(c.config.ClusterName, component, item.GetNamespace(), item.GetName())
+				expected[t] = struct{}{}
+				continue
+			}
+			t := topic.DiscoveryTopicWithPrefix(topic.DefaultDiscoveryPrefix, c.config.ClusterName, kind, item.GetNamespace(), item.GetName())
 			expected[t] = struct{}{}
 		}
 	}
 
+	if !genericListed {
+		c.log.Info("Failed to list MQTTEntity CRs, will not GC any type")
+		return expected, make(map[string]struct{})
+	}
 	return expected, verifiedComponents
 }
 
 // findOrphans returns topics from discovered entities that are not in the expected set.
 // Only entities whose component type is in verifiedComponents are considered;
 // if we failed to list a component type, we skip its entities to avoid false positives.
-func findOrphans(ours []discoveredEntity, expected map[string]struct{}, verifiedComponents map[string]struct{}) []string {
+func findOrphans(ours []DiscoveredEntity, expected map[string]struct{}, verifiedComponents map[string]struct{}) []string {
 	var orphans []string
 	for _, e := range ours {
 		info, err := topic.ParseDiscoveryTopic(e.Topic)
@@ -311,7 +818,7 @@ func findOrphans(ours []discoveredEntity, expected map[string]struct{}, verified
 			continue
 		}
 		// Only consider entities whose component type we successfully listed
-		if _, verified := verifiedComponents[info.Component]; !verified {
+		if !isVerified(info.Component, verifiedComponents) {
 			continue
 		}
 		if _, ok := expected[e.Topic]; !ok {
@@ -320,3 +827,14 @@ func findOrphans(ours []discoveredEntity, expected map[string]struct{}, verified
 	}
 	return orphans
 }
+
+// isVerified reports whether the resources published as component were all
+// listed: those of its typed kind, or the MQTTEntity resources for components
+// without one.
+func isVerified(component string, verifiedComponents map[string]struct{}) bool {
+	if _, ok := verifiedComponents[component]; ok {
+		return true
+	}
+	_, ok := verifiedComponents[anyComponent]
+	return ok && registry.ForComponent(component) == nil
+}
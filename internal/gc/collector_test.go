@@ -20,11 +20,18 @@ import (
 	"context"
 	"encoding/json"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
 	"github.com/spontus/hass-crds/internal/mqtt"
 )
 
@@ -36,14 +43,14 @@ func TestFindOrphans(t *testing.T) {
 
 	tests := []struct {
 		name               string
-		ours               []discoveredEntity
+		ours               []DiscoveredEntity
 		expected           map[string]struct{}
 		verifiedComponents map[string]struct{}
 		want               []string
 	}{
 		{
 			name: "no orphans",
-			ours: []discoveredEntity{
+			ours: []DiscoveredEntity{
 				{Topic: "homeassistant/button/default/btn1/config"},
 				{Topic: "homeassistant/sensor/default/temp/config"},
 			},
@@ -56,7 +63,7 @@ func TestFindOrphans(t *testing.T) {
 		},
 		{
 			name: "one orphan",
-			ours: []discoveredEntity{
+			ours: []DiscoveredEntity{
 				{Topic: "homeassistant/button/default/btn1/config"},
 				{Topic: "homeassistant/button/default/btn2/config"},
 			},
@@ -68,7 +75,7 @@ func TestFindOrphans(t *testing.T) {
 		},
 		{
 			name: "all orphans",
-			ours: []discoveredEntity{
+			ours: []DiscoveredEntity{
 				{Topic: "homeassistant/sensor/ns/s1/config"},
 				{Topic: "homeassistant/sensor/ns/s2/config"},
 			},
@@ -88,7 +95,7 @@ func TestFindOrphans(t *testing.T) {
 		},
 		{
 			name: "skips unverified component",
-			ours: []discoveredEntity{
+			ours: []DiscoveredEntity{
 				{Topic: "homeassistant/button/default/btn1/config"},
 				{Topic: "homeassistant/image/default/img1/config"},
 			},
@@ -96,9 +103,20 @@ func TestFindOrphans(t *testing.T) {
 			verifiedComponents: map[string]struct{}{"button": {}}, // image NOT verified
 			want:               []string{"homeassistant/button/default/btn1/config"},
 		},
+		{
+			name: "generic entities verify untyped components",
+			ours: []DiscoveredEntity{
+				{Topic: "homeassistant/future_platform/default/f1/config"},
+				{Topic: "homeassistant/future_platform/default/f2/config"},
+				{Topic: "homeassistant/image/default/img1/config"},
+			},
+			expected:           map[string]struct{}{"homeassistant/future_platform/default/f1/config": {}},
+			verifiedComponents: map[string]struct{}{"button": {}, anyComponent: {}}, // image has a typed kind
+			want:               []string{"homeassistant/future_platform/default/f2/config"},
+		},
 		{
 			name: "skips invalid topic format",
-			ours: []discoveredEntity{
+			ours: []DiscoveredEntity{
 				{Topic: "bad-topic"},
 				{Topic: "homeassistant/button/default/btn1/config"},
 			},
@@ -148,6 +166,14 @@ func TestHasOurOrigin(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "other hass-crds instance",
+			payload: map[string]interface{}{
+				"name":   "Test",
+				"origin": map[string]interface{}{"name": "hass-crds/office"},
+			},
+			want: false,
+		},
 		{
 			name: "no origin field",
 			payload: map[string]interface{}{
@@ -174,7 +200,7 @@ func TestHasOurOrigin(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			data, _ := json.Marshal(tt.payload)
-			got := hasOurOrigin(data)
+			got := hasOurOrigin(data, "hass-crds")
 			if got != tt.want {
 				t.Errorf("hasOurOrigin() = %v, want %v", got, tt.want)
 			}
@@ -183,7 +209,7 @@ func TestHasOurOrigin(t *testing.T) {
 }
 
 func TestHasOurOrigin_InvalidJSON(t *testing.T) {
-	if hasOurOrigin([]byte("not json")) {
+	if hasOurOrigin([]byte("not json"), "hass-crds") {
 		t.Error("hasOurOrigin(invalid json) should return false")
 	}
 }
@@ -201,14 +227,14 @@ func TestFilterOurEntities(t *testing.T) {
 		"name": "NoOrigin",
 	})
 
-	entities := []discoveredEntity{
+	entities := []DiscoveredEntity{
 		{Topic: "homeassistant/button/default/ours/config", Payload: ourPayload},
 		{Topic: "homeassistant/button/default/other/config", Payload: otherPayload},
 		{Topic: "homeassistant/button/default/none/config", Payload: noOriginPayload},
 		{Topic: "homeassistant/button/default/empty/config", Payload: []byte{}},
 	}
 
-	result := filterOurEntities(entities)
+	result := filterOurEntities(entities, "hass-crds")
 	if len(result) != 1 {
 		t.Fatalf("filterOurEntities() returned %d entities, want 1", len(result))
 	}
@@ -221,7 +247,7 @@ func TestCollect_RemovesOrphans(t *testing.T) {
 	mockClient := mqtt.NewMockClient()
 	_ = mockClient.Connect(context.Background())
 
-	collector := NewOrphanCollector(nil, mockClient, logr.Discard(), Config{
+	collector := NewOrphanCollector(nil, mockClient, nil, logr.Discard(), Config{
 		Enabled:        true,
 		Interval:       time.Minute,
 		SilenceTimeout: 100 * time.Millisecond,
@@ -250,7 +276,7 @@ func TestCollect_RemovesOrphans(t *testing.T) {
 		t.Fatalf("collectDiscoveryMessages() error: %v", err)
 	}
 
-	ours := filterOurEntities(entities)
+	ours := filterOurEntities(entities, "hass-crds")
 	if len(ours) != 1 {
 		t.Fatalf("expected 1 entity with our origin, got %d", len(ours))
 	}
@@ -285,7 +311,7 @@ func TestCollect_IgnoresNonOurEntities(t *testing.T) {
 	mockClient := mqtt.NewMockClient()
 	_ = mockClient.Connect(context.Background())
 
-	collector := NewOrphanCollector(nil, mockClient, logr.Discard(), Config{
+	collector := NewOrphanCollector(nil, mockClient, nil, logr.Discard(), Config{
 		Enabled:        true,
 		SilenceTimeout: 100 * time.Millisecond,
 	})
@@ -306,7 +332,7 @@ func TestCollect_IgnoresNonOurEntities(t *testing.T) {
 		t.Fatalf("collectDiscoveryMessages() error: %v", err)
 	}
 
-	ours := filterOurEntities(entities)
+	ours := filterOurEntities(entities, "hass-crds")
 	if len(ours) != 0 {
 		t.Fatalf("expected 0 entities with our origin, got %d", len(ours))
 	}
@@ -334,6 +360,45 @@ func TestConfigFromEnv(t *testing.T) {
 				if cfg.SilenceTimeout != 5*time.Second {
 					t.Errorf("expected SilenceTimeout=5s, got %v", cfg.SilenceTimeout)
 				}
+				if cfg.StaleCycles != 3 {
+					t.Errorf("expected StaleCycles=3, got %d", cfg.StaleCycles)
+				}
+				if cfg.DryRun {
+					t.Error("expected DryRun=false by default")
+				}
+				if cfg.ReportName != "" {
+					t.Errorf("expected ReportName to be derived, got %s", cfg.ReportName)
+				}
+				if cfg.GraceCycles != 3 {
+					t.Errorf("expected GraceCycles=3, got %d", cfg.GraceCycles)
+				}
+				if cfg.MaxDeletions != 25 || cfg.MaxDeletionPercent != 0 {
+					t.Errorf("expected MaxDeletions=25 MaxDeletionPercent=0, got %d %d", cfg.MaxDeletions, cfg.MaxDeletionPercent)
+				}
+			},
+		},
+		{
+			name: "blast radius",
+			env:  map[string]string{"GC_GRACE_CYCLES": "1", "GC_MAX_DELETIONS": "0", "GC_MAX_DELETION_PERCENT": "20"},
+			validate: func(t *testing.T, cfg Config) {
+				if cfg.GraceCycles != 1 {
+					t.Errorf("expected GraceCycles=1, got %d", cfg.GraceCycles)
+				}
+				if cfg.MaxDeletions != 0 || cfg.MaxDeletionPercent != 20 {
+					t.Errorf("expected MaxDeletions=0 MaxDeletionPercent=20, got %d %d", cfg.MaxDeletions, cfg.MaxDeletionPercent)
+				}
+			},
+		},
+		{
+			name: "dry run",
+			env:  map[string]string{"GC_DRY_RUN": "true", "GC_REPORT_NAME": "home"},
+			validate: func(t *testing.T, cfg Config) {
+				if !cfg.DryRun {
+					t.Error("expected DryRun=true")
+				}
+				if cfg.ReportName != "home" {
+					t.Errorf("expected ReportName=home, got %s", cfg.ReportName)
+				}
 			},
 		},
 		{
@@ -386,7 +451,7 @@ func TestConfigFromEnv(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Clear all GC env vars (t.Setenv handles cleanup)
-			for _, key := range []string{"GC_ENABLED", "GC_INTERVAL", "GC_RUN_ON_STARTUP", "GC_SILENCE_TIMEOUT"} {
+			for _, key := range []string{"GC_ENABLED", "GC_INTERVAL", "GC_RUN_ON_STARTUP", "GC_SILENCE_TIMEOUT", "GC_DRY_RUN", "GC_REPORT_NAME", "GC_GRACE_CYCLES", "GC_MAX_DELETIONS", "GC_MAX_DELETION_PERCENT"} {
 				t.Setenv(key, "")
 			}
 			// Set test env vars (overrides the empty values above)
@@ -399,3 +464,307 @@ func TestConfigFromEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthCheck(t *testing.T) {
+	cfg := Config{Enabled: true, Interval: time.Minute, StaleCycles: 3}
+
+	tests := []struct {
+		name        string
+		config      Config
+		started     time.Duration // ago; zero means not started
+		lastSuccess time.Duration // ago; zero means never
+		wantErr     bool
+	}{
+		{name: "not started", config: cfg},
+		{name: "disabled", config: Config{Enabled: false, Interval: time.Minute}, started: time.Hour},
+		{name: "first cycle pending", config: cfg, started: time.Minute},
+		{name: "first cycle overdue", config: cfg, started: 5 * time.Minute, wantErr: true},
+		{name: "recent success", config: cfg, started: time.Hour, lastSuccess: 2 * time.Minute},
+		{name: "stale", config: cfg, started: time.Hour, lastSuccess: 4 * time.Minute, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewOrphanCollector(nil, mqtt.NewMockClient(), nil, logr.Discard(), tt.config)
+			if tt.started > 0 {
+				c.started = time.Now().Add(-tt.started)
+			}
+			if tt.lastSuccess > 0 {
+				c.lastSuccess = time.Now().Add(-tt.lastSuccess)
+			}
+
+			err := c.HealthCheck(nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HealthCheck() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// newTestCollector returns a collector backed by a fake Kubernetes client
+// holding objects and a connected mock MQTT client.
+func newTestCollector(t *testing.T, config Config, objects ...client.Object) (*OrphanCollector, *mqtt.MockClient, client.Client, *record.FakeRecorder) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&mqttv1alpha1.MQTTGarbageCollection{}).
+		Build()
+
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	recorder := record.NewFakeRecorder(10)
+	config.Enabled = true
+	config.SilenceTimeout = 100 * time.Millisecond
+	config.ReportName = DefaultReportName
+
+	return NewOrphanCollector(k8sClient, mockClient, recorder, logr.Discard(), config), mockClient, k8sClient, recorder
+}
+
+// runWithRetained runs a manual cycle while the broker holds the retained
+// discovery payloads in retained.
+func runWithRetained(t *testing.T, c *OrphanCollector, mockClient *mqtt.MockClient, retained map[string][]byte) *mqttv1alpha1.GarbageCollectionRun {
+	t.Helper()
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		for topic, payload := range retained {
+			mockClient.SimulateMessage(topic, payload)
+		}
+	}()
+
+	if err := c.run(context.Background(), mqttv1alpha1.GarbageCollectionTriggerManual, "t1"); err != nil {
+		t.Fatalf("run() error: %v", err)
+	}
+	return c.LastRun()
+}
+
+func TestRun_DryRunReport(t *testing.T) {
+	dryRun := true
+	collector, mockClient, k8sClient, _ := newTestCollector(t, Config{}, &mqttv1alpha1.MQTTGarbageCollection{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultReportName},
+		Spec:       mqttv1alpha1.MQTTGarbageCollectionSpec{DryRun: &dryRun},
+	})
+
+	orphanPayload, _ := json.Marshal(map[string]interface{}{
+		"name":   "Orphan",
+		"origin": map[string]interface{}{"name": "hass-crds", "sw_version": "1.2.0"},
+	})
+	runWithRetained(t, collector, mockClient, map[string][]byte{
+		"homeassistant/button/default/orphan-btn/config": orphanPayload,
+	})
+
+	for _, msg := range mockClient.GetPublishedMessages() {
+		if len(msg.Payload) == 0 {
+			t.Errorf("dry run removed %s", msg.Topic)
+		}
+	}
+
+	var gc mqttv1alpha1.MQTTGarbageCollection
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Name: DefaultReportName}, &gc); err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	if gc.Status.ObservedTrigger != "t1" {
+		t.Errorf("ObservedTrigger = %q, want t1", gc.Status.ObservedTrigger)
+	}
+
+	run := gc.Status.LastRun
+	if run == nil {
+		t.Fatal("LastRun not recorded")
+	}
+	if run.Result != mqttv1alpha1.GarbageCollectionSucceeded || !run.DryRun || run.Trigger != mqttv1alpha1.GarbageCollectionTriggerManual {
+		t.Errorf("LastRun = %s/dryRun=%v/%s, want Succeeded/dryRun=true/Manual", run.Result, run.DryRun, run.Trigger)
+	}
+	if run.Scanned != 1 || run.OrphanCount != 1 || len(run.Orphans) != 1 {
+		t.Fatalf("Scanned=%d OrphanCount=%d Orphans=%d, want 1, 1, 1", run.Scanned, run.OrphanCount, len(run.Orphans))
+	}
+
+	want := mqttv1alpha1.OrphanReport{
+		Topic:           "homeassistant/button/default/orphan-btn/config",
+		Component:       "button",
+		OriginName:      "hass-crds",
+		OriginSwVersion: "1.2.0",
+		ObservedCycles:  1,
+		Action:          mqttv1alpha1.OrphanActionWouldRemove,
+	}
+	if run.Orphans[0] != want {
+		t.Errorf("Orphans[0] = %+v, want %+v", run.Orphans[0], want)
+	}
+}
+
+func TestRun_GraceCycles(t *testing.T) {
+	collector, mockClient, _, _ := newTestCollector(t, Config{GraceCycles: 2})
+
+	orphanTopic := "homeassistant/button/default/orphan-btn/config"
+	retained := map[string][]byte{orphanTopic: []byte(`{"origin":{"name":"hass-crds"}}`)}
+
+	run := runWithRetained(t, collector, mockClient, retained)
+	if len(run.Orphans) != 1 || run.Orphans[0].Action != mqttv1alpha1.OrphanActionPending {
+		t.Fatalf("first cycle orphans = %+v, want one Pending", run.Orphans)
+	}
+	if n := len(mockClient.GetPublishedMessages()); n != 0 {
+		t.Fatalf("first cycle published %d messages, want 0", n)
+	}
+
+	run = runWithRetained(t, collector, mockClient, retained)
+	if len(run.Orphans) != 1 || run.Orphans[0].Action != mqttv1alpha1.OrphanActionRemoved || run.Orphans[0].ObservedCycles != 2 {
+		t.Fatalf("second cycle orphans = %+v, want one Removed after 2 cycles", run.Orphans)
+	}
+}
+
+func TestRun_DeletionCapRefused(t *testing.T) {
+	collector, mockClient, _, recorder := newTestCollector(t, Config{GraceCycles: 1, MaxDeletions: 1})
+
+	retained := map[string][]byte{
+		"homeassistant/button/default/a/config": []byte(`{"origin":{"name":"hass-crds"}}`),
+		"homeassistant/button/default/b/config": []byte(`{"origin":{"name":"hass-crds"}}`),
+	}
+	run := runWithRetained(t, collector, mockClient, retained)
+
+	if run.Result != mqttv1alpha1.GarbageCollectionRefused {
+		t.Errorf("Result = %s, want Refused", run.Result)
+	}
+	for _, o := range run.Orphans {
+		if o.Action != mqttv1alpha1.OrphanActionRefused {
+			t.Errorf("orphan %s action = %s, want Refused", o.Topic, o.Action)
+		}
+	}
+	if n := len(mockClient.GetPublishedMessages()); n != 0 {
+		t.Errorf("refused cycle published %d messages, want 0", n)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "DeletionCapExceeded") {
+			t.Errorf("event = %q, want DeletionCapExceeded", event)
+		}
+	default:
+		t.Error("no event recorded for refused cycle")
+	}
+}
+
+func TestRun_ProtectedDevice(t *testing.T) {
+	collector, mockClient, _, _ := newTestCollector(t, Config{GraceCycles: 1}, &mqttv1alpha1.MQTTDevice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nas",
+			Namespace: "default",
+			Labels:    map[string]string{mqttv1alpha1.GarbageCollectionProtectAnnotation: "true"},
+		},
+		Spec: mqttv1alpha1.MQTTDeviceSpec{Identifiers: []string{"nas-01"}},
+	})
+
+	retained := map[string][]byte{
+		"homeassistant/sensor/default/nas-temp/config": []byte(`{"origin":{"name":"hass-crds"},"device":{"identifiers":["nas-01"]}}`),
+		"homeassistant/sensor/default/other/config":    []byte(`{"origin":{"name":"hass-crds"},"device":{"identifiers":"other"}}`),
+	}
+	run := runWithRetained(t, collector, mockClient, retained)
+
+	actions := map[string]string{}
+	for _, o := range run.Orphans {
+		actions[o.Topic] = o.Action
+	}
+	if got := actions["homeassistant/sensor/default/nas-temp/config"]; got != mqttv1alpha1.OrphanActionProtected {
+		t.Errorf("protected device orphan action = %s, want Protected", got)
+	}
+	if got := actions["homeassistant/sensor/default/other/config"]; got != mqttv1alpha1.OrphanActionRemoved {
+		t.Errorf("unprotected orphan action = %s, want Removed", got)
+	}
+}
+
+func TestRun_ClusterName(t *testing.T) {
+	collector, mockClient, _, _ := newTestCollector(t, Config{GraceCycles: 1, ClusterName: "office"}, &mqttv1alpha1.MQTTButton{
+		ObjectMeta: metav1.ObjectMeta{Name: "door", Namespace: "home"},
+	})
+
+	retained := map[string][]byte{
+		"homeassistant/button/office_home/door/config": []byte(`{"origin":{"name":"hass-crds"}}`),
+		"homeassistant/button/home/door/config":        []byte(`{"origin":{"name":"hass-crds"}}`),
+	}
+	run := runWithRetained(t, collector, mockClient, retained)
+
+	if len(run.Orphans) != 1 || run.Orphans[0].Topic != "homeassistant/button/home/door/config" {
+		t.Errorf("orphans = %+v, want only the topic without the cluster name", run.Orphans)
+	}
+}
+
+func TestCheckDeletionCap(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    Config
+		deletions int
+		scanned   int
+		wantErr   bool
+	}{
+		{name: "no caps", config: Config{}, deletions: 100, scanned: 100},
+		{name: "within count", config: Config{MaxDeletions: 5}, deletions: 5, scanned: 100},
+		{name: "over count", config: Config{MaxDeletions: 5}, deletions: 6, scanned: 100, wantErr: true},
+		{name: "within percent", config: Config{MaxDeletionPercent: 50}, deletions: 5, scanned: 10},
+		{name: "over percent", config: Config{MaxDeletionPercent: 50}, deletions: 6, scanned: 10, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.checkDeletionCap(tt.deletions, tt.scanned)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkDeletionCap() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewOrphanCollector_Instance(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     Config
+		wantReport string
+		wantOrigin string
+	}{
+		{name: "default instance", wantReport: "hass-crds", wantOrigin: "hass-crds"},
+		{name: "named instance", config: Config{InstanceID: "office"}, wantReport: "office-hass-crds", wantOrigin: "hass-crds/office"},
+		{name: "explicit report name", config: Config{InstanceID: "office", ReportName: "gc"}, wantReport: "gc", wantOrigin: "hass-crds/office"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewOrphanCollector(nil, mqtt.NewMockClient(), nil, logr.Discard(), tt.config)
+			if c.ReportName() != tt.wantReport {
+				t.Errorf("ReportName() = %q, want %q", c.ReportName(), tt.wantReport)
+			}
+			if c.originName != tt.wantOrigin {
+				t.Errorf("originName = %q, want %q", c.originName, tt.wantOrigin)
+			}
+		})
+	}
+}
+
+func TestPendingTrigger(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		observed   string
+		want       string
+	}{
+		{name: "no annotation"},
+		{name: "new trigger", annotation: "2", observed: "1", want: "2"},
+		{name: "already observed", annotation: "2", observed: "2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gc := &mqttv1alpha1.MQTTGarbageCollection{}
+			if tt.annotation != "" {
+				gc.Annotations = map[string]string{mqttv1alpha1.GarbageCollectionTriggerAnnotation: tt.annotation}
+			}
+			gc.Status.ObservedTrigger = tt.observed
+
+			if got := pendingTrigger(gc); got != tt.want {
+				t.Errorf("pendingTrigger() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
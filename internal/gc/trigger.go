@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+// TriggerReconciler watches the MQTTGarbageCollection resource and runs a
+// garbage collection cycle when its gc-trigger annotation changes.
+type TriggerReconciler struct {
+	client.Client
+	collector *OrphanCollector
+}
+
+// NewTriggerReconciler creates a new TriggerReconciler for collector.
+func NewTriggerReconciler(c client.Client, collector *OrphanCollector) *TriggerReconciler {
+	return &TriggerReconciler{
+		Client:    c,
+		collector: collector,
+	}
+}
+
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttgarbagecollections,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=mqtt.home-assistant.io,resources=mqttgarbagecollections/status,verbs=get;update;patch
+
+func (r *TriggerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if !r.collector.config.Enabled || req.Name != r.collector.config.ReportName {
+		return ctrl.Result{}, nil
+	}
+
+	var gc mqttv1alpha1.MQTTGarbageCollection
+	if err := r.Get(ctx, req.NamespacedName, &gc); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if trigger := pendingTrigger(&gc); trigger != "" {
+		r.collector.Trigger(trigger)
+	}
+	return ctrl.Result{}, nil
+}
+
+// pendingTrigger returns the gc-trigger annotation if it has not been acted on yet.
+func pendingTrigger(gc *mqttv1alpha1.MQTTGarbageCollection) string {
+	trigger := gc.Annotations[mqttv1alpha1.GarbageCollectionTriggerAnnotation]
+	if trigger == gc.Status.ObservedTrigger {
+		return ""
+	}
+	return trigger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TriggerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mqttv1alpha1.MQTTGarbageCollection{}).
+		Named("mqttgarbagecollection").
+		Complete(r)
+}
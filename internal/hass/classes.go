@@ -0,0 +1,203 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hass
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Classes are the payload values Home Assistant interprets by device class.
+type Classes struct {
+	DeviceClass string
+	Unit        string
+	StateClass  string
+}
+
+// ClassesOf returns the classes set in a discovery payload.
+func ClassesOf(payload map[string]interface{}) Classes {
+	str := func(key string) string {
+		s, _ := payload[key].(string)
+		return s
+	}
+	return Classes{
+		DeviceClass: str("device_class"),
+		Unit:        str("unit_of_measurement"),
+		StateClass:  str("state_class"),
+	}
+}
+
+const (
+	StateClassMeasurement     = "measurement"
+	StateClassTotal           = "total"
+	StateClassTotalIncreasing = "total_increasing"
+)
+
+// numericClass describes a device class of sensors and numbers.
+type numericClass struct {
+	// units are the units Home Assistant converts between. A nil list means
+	// the value has no unit; anyUnit allows any unit, such as a currency.
+	units   []string
+	anyUnit bool
+	// noUnit allows the unit to be left out as well.
+	noUnit bool
+	// stateClasses are the state classes that keep statistics meaningful;
+	// none are allowed for non-numeric values.
+	stateClasses []string
+	// sensorOnly classes are not available to numbers.
+	sensorOnly bool
+}
+
+var (
+	measurement = []string{StateClassMeasurement}
+	totals      = []string{StateClassTotal, StateClassTotalIncreasing}
+	anyState    = []string{StateClassMeasurement, StateClassTotal, StateClassTotalIncreasing}
+
+	energyUnits   = []string{"J", "kJ", "MJ", "GJ", "mWh", "Wh", "kWh", "MWh", "GWh", "TWh", "cal", "kcal", "Mcal", "Gcal"}
+	pressureUnits = []string{"Pa", "kPa", "hPa", "bar", "cbar", "mbar", "mmHg", "inHg", "psi"}
+	speedUnits    = []string{"ft/s", "in/d", "in/h", "in/s", "km/h", "kn", "m/s", "mph", "mm/d", "mm/s"}
+	volumeUnits   = []string{"L", "mL", "gal", "fl. oz.", "m³", "ft³", "CCF"}
+	densityUnits  = []string{"µg/m³"}
+)
+
+// numericClasses are Home Assistant's sensor and number device classes with
+// their units and state classes.
+var numericClasses = map[string]numericClass{
+	"apparent_power":                   {units: []string{"VA"}, stateClasses: measurement},
+	"aqi":                              {stateClasses: measurement},
+	"atmospheric_pressure":             {units: pressureUnits, stateClasses: measurement},
+	"battery":                          {units: []string{"%"}, stateClasses: measurement},
+	"blood_glucose_concentration":      {units: []string{"mg/dL", "mmol/L"}, stateClasses: measurement},
+	"carbon_dioxide":                   {units: []string{"ppm"}, stateClasses: measurement},
+	"carbon_monoxide":                  {units: []string{"ppm"}, stateClasses: measurement},
+	"conductivity":                     {units: []string{"S/cm", "mS/cm", "µS/cm"}, stateClasses: measurement},
+	"current":                          {units: []string{"A", "mA"}, stateClasses: measurement},
+	"data_rate":                        {units: []string{"bit/s", "kbit/s", "Mbit/s", "Gbit/s", "B/s", "kB/s", "MB/s", "GB/s", "KiB/s", "MiB/s", "GiB/s"}, stateClasses: measurement},
+	"data_size":                        {units: []string{"bit", "kbit", "Mbit", "Gbit", "B", "kB", "MB", "GB", "TB", "PB", "EB", "ZB", "YB", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB", "ZiB", "YiB"}, stateClasses: anyState},
+	"date":                             {sensorOnly: true},
+	"distance":                         {units: []string{"km", "m", "cm", "mm", "mi", "nmi", "yd", "in", "ft"}, stateClasses: anyState},
+	"duration":                         {units: []string{"d", "h", "min", "s", "ms"}, stateClasses: anyState},
+	"energy":                           {units: energyUnits, stateClasses: totals},
+	"energy_storage":                   {units: energyUnits, stateClasses: measurement},
+	"enum":                             {sensorOnly: true},
+	"frequency":                        {units: []string{"Hz", "kHz", "MHz", "GHz"}, stateClasses: measurement},
+	"gas":                              {units: []string{"m³", "ft³", "CCF", "L"}, stateClasses: totals},
+	"humidity":                         {units: []string{"%"}, stateClasses: measurement},
+	"illuminance":                      {units: []string{"lx"}, stateClasses: measurement},
+	"irradiance":                       {units: []string{"W/m²", "BTU/(h⋅ft²)"}, stateClasses: measurement},
+	"moisture":                         {units: []string{"%"}, stateClasses: measurement},
+	"monetary":                         {anyUnit: true, stateClasses: []string{StateClassTotal}},
+	"nitrogen_dioxide":                 {units: densityUnits, stateClasses: measurement},
+	"nitrogen_monoxide":                {units: densityUnits, stateClasses: measurement},
+	"nitrous_oxide":                    {units: densityUnits, stateClasses: measurement},
+	"ozone":                            {units: densityUnits, stateClasses: measurement},
+	"ph":                               {stateClasses: measurement},
+	"pm1":                              {units: densityUnits, stateClasses: measurement},
+	"pm10":                             {units: densityUnits, stateClasses: measurement},
+	"pm25":                             {units: densityUnits, stateClasses: measurement},
+	"power":                            {units: []string{"mW", "W", "kW", "MW", "GW", "TW", "BTU/h"}, stateClasses: measurement},
+	"power_factor":                     {units: []string{"%"}, noUnit: true, stateClasses: measurement},
+	"precipitation":                    {units: []string{"cm", "in", "mm"}, stateClasses: anyState},
+	"precipitation_intensity":          {units: []string{"in/d", "in/h", "mm/d", "mm/h"}, stateClasses: measurement},
+	"pressure":                         {units: pressureUnits, stateClasses: measurement},
+	"reactive_power":                   {units: []string{"var", "kvar"}, stateClasses: measurement},
+	"signal_strength":                  {units: []string{"dB", "dBm"}, stateClasses: measurement},
+	"sound_pressure":                   {units: []string{"dB", "dBA"}, stateClasses: measurement},
+	"speed":                            {units: speedUnits, stateClasses: measurement},
+	"sulphur_dioxide":                  {units: densityUnits, stateClasses: measurement},
+	"temperature":                      {units: []string{"°C", "°F", "K"}, stateClasses: measurement},
+	"timestamp":                        {sensorOnly: true},
+	"volatile_organic_compounds":       {units: []string{"µg/m³", "mg/m³"}, stateClasses: measurement},
+	"volatile_organic_compounds_parts": {units: []string{"ppm", "ppb"}, stateClasses: measurement},
+	"voltage":                          {units: []string{"µV", "mV", "V", "kV", "MV"}, stateClasses: measurement},
+	"volume":                           {units: volumeUnits, stateClasses: totals},
+	"volume_flow_rate":                 {units: []string{"m³/h", "ft³/min", "L/min", "gal/min", "mL/s"}, stateClasses: measurement},
+	"volume_storage":                   {units: volumeUnits, stateClasses: measurement},
+	"water":                            {units: []string{"L", "gal", "m³", "ft³", "CCF"}, stateClasses: totals},
+	"weight":                           {units: []string{"kg", "g", "mg", "µg", "oz", "lb", "st"}, stateClasses: []string{StateClassMeasurement, StateClassTotal}},
+	"wind_speed":                       {units: speedUnits, stateClasses: measurement},
+}
+
+// deviceClasses are the device classes of components whose device class
+// only changes how the entity is shown.
+var deviceClasses = map[string][]string{
+	"binary_sensor": {
+		"battery", "battery_charging", "carbon_monoxide", "cold", "connectivity", "door", "garage_door",
+		"gas", "heat", "light", "lock", "moisture", "motion", "moving", "occupancy", "opening", "plug",
+		"power", "presence", "problem", "running", "safety", "smoke", "sound", "tamper", "update",
+		"vibration", "window",
+	},
+	"button": {"identify", "restart", "update"},
+	"cover":  {"awning", "blind", "curtain", "damper", "door", "garage", "gate", "shade", "shutter", "window"},
+	"event":  {"button", "doorbell", "motion"},
+	"switch": {"outlet", "switch"},
+	"update": {"firmware"},
+}
+
+// CheckClasses returns the problems Home Assistant reports, or the
+// statistics it drops, for the classes of a component's payload. It covers
+// sensor, binary_sensor, number, cover, button, switch, event and update;
+// other components have no problems. The problems do not stop Home
+// Assistant from creating the entity, so they suit admission warnings.
+func CheckClasses(component string, c Classes) []string {
+	switch component {
+	case "sensor", "number":
+		return checkNumeric(component, c)
+	}
+
+	classes, ok := deviceClasses[component]
+	if !ok || c.DeviceClass == "" || slices.Contains(classes, c.DeviceClass) {
+		return nil
+	}
+	return []string{fmt.Sprintf("device class %q is not a %s device class", c.DeviceClass, component)}
+}
+
+func checkNumeric(component string, c Classes) []string {
+	var problems []string
+
+	if c.StateClass != "" && component == "number" {
+		problems = append(problems, "numbers have no state class")
+	}
+	if c.DeviceClass == "" {
+		return problems
+	}
+
+	class, ok := numericClasses[c.DeviceClass]
+	if !ok || class.sensorOnly && component == "number" {
+		return append(problems, fmt.Sprintf("device class %q is not a %s device class", c.DeviceClass, component))
+	}
+
+	switch {
+	case class.anyUnit:
+	case class.units == nil && c.Unit != "":
+		problems = append(problems, fmt.Sprintf("device class %q has no unit, got %q", c.DeviceClass, c.Unit))
+	case class.units != nil && c.Unit == "" && !class.noUnit:
+		problems = append(problems, fmt.Sprintf("device class %q needs a unit of %s", c.DeviceClass, strings.Join(class.units, ", ")))
+	case class.units != nil && c.Unit != "" && !slices.Contains(class.units, c.Unit):
+		problems = append(problems, fmt.Sprintf("unit %q is not a unit of device class %q, use one of %s", c.Unit, c.DeviceClass, strings.Join(class.units, ", ")))
+	}
+
+	if c.StateClass != "" && component == "sensor" && !slices.Contains(class.stateClasses, c.StateClass) {
+		if len(class.stateClasses) == 0 {
+			problems = append(problems, fmt.Sprintf("device class %q has no state class, got %q", c.DeviceClass, c.StateClass))
+		} else {
+			problems = append(problems, fmt.Sprintf("state class %q is not valid for device class %q, use %s", c.StateClass, c.DeviceClass, strings.Join(class.stateClasses, " or ")))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hass
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestClassesOf(t *testing.T) {
+	got := ClassesOf(map[string]interface{}{
+		"device_class":        "temperature",
+		"unit_of_measurement": "°C",
+		"state_class":         "measurement",
+		"name":                "Temperature",
+	})
+	want := Classes{DeviceClass: "temperature", Unit: "°C", StateClass: "measurement"}
+	if got != want {
+		t.Errorf("ClassesOf() = %+v, want %+v", got, want)
+	}
+	if got := ClassesOf(map[string]interface{}{}); got != (Classes{}) {
+		t.Errorf("ClassesOf(empty) = %+v, want zero", got)
+	}
+}
+
+func TestCheckClasses(t *testing.T) {
+	tests := []struct {
+		name      string
+		component string
+		classes   Classes
+		problems  []string
+	}{
+		{
+			name:      "no classes",
+			component: "sensor",
+		},
+		{
+			name:      "compatible sensor",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "temperature", Unit: "°C", StateClass: "measurement"},
+		},
+		{
+			name:      "energy total",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "energy", Unit: "kWh", StateClass: "total_increasing"},
+		},
+		{
+			name:      "monetary takes any currency",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "monetary", Unit: "EUR", StateClass: "total"},
+		},
+		{
+			name:      "power factor without unit",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "power_factor"},
+		},
+		{
+			name:      "unknown sensor class",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "temprature"},
+			problems:  []string{`device class "temprature" is not a sensor device class`},
+		},
+		{
+			name:      "wrong unit",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "temperature", Unit: "C"},
+			problems:  []string{`unit "C" is not a unit of device class "temperature", use one of °C, °F, K`},
+		},
+		{
+			name:      "missing unit",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "humidity"},
+			problems:  []string{`device class "humidity" needs a unit of %`},
+		},
+		{
+			name:      "unit on unitless class",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "aqi", Unit: "%"},
+			problems:  []string{`device class "aqi" has no unit, got "%"`},
+		},
+		{
+			name:      "measurement of energy",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "energy", Unit: "kWh", StateClass: "measurement"},
+			problems:  []string{`state class "measurement" is not valid for device class "energy", use total or total_increasing`},
+		},
+		{
+			name:      "state class on timestamp",
+			component: "sensor",
+			classes:   Classes{DeviceClass: "timestamp", StateClass: "measurement"},
+			problems:  []string{`device class "timestamp" has no state class, got "measurement"`},
+		},
+		{
+			name:      "compatible number",
+			component: "number",
+			classes:   Classes{DeviceClass: "temperature", Unit: "°F"},
+		},
+		{
+			name:      "number with sensor-only class and state class",
+			component: "number",
+			classes:   Classes{DeviceClass: "timestamp", StateClass: "measurement"},
+			problems: []string{
+				"numbers have no state class",
+				`device class "timestamp" is not a number device class`,
+			},
+		},
+		{
+			name:      "binary sensor",
+			component: "binary_sensor",
+			classes:   Classes{DeviceClass: "motion"},
+		},
+		{
+			name:      "unknown cover class",
+			component: "cover",
+			classes:   Classes{DeviceClass: "temperature"},
+			problems:  []string{`device class "temperature" is not a cover device class`},
+		},
+		{
+			name:      "update",
+			component: "update",
+			classes:   Classes{DeviceClass: "firmware"},
+		},
+		{
+			name:      "unchecked component",
+			component: "light",
+			classes:   Classes{DeviceClass: "anything"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckClasses(tt.component, tt.classes)
+			if !reflect.DeepEqual(got, tt.problems) {
+				t.Errorf("CheckClasses() = %q, want %q", got, tt.problems)
+			}
+		})
+	}
+}
+
+func TestNumericClasses(t *testing.T) {
+	stateClasses := []string{StateClassMeasurement, StateClassTotal, StateClassTotalIncreasing}
+	for name, class := range numericClasses {
+		if name != strings.ToLower(name) {
+			t.Errorf("%s is not a lower case device class", name)
+		}
+		if class.anyUnit && class.units != nil {
+			t.Errorf("%s lists units but takes any unit", name)
+		}
+		if class.noUnit && class.units == nil {
+			t.Errorf("%s takes no unit but lists none", name)
+		}
+		for _, sc := range class.stateClasses {
+			if !slices.Contains(stateClasses, sc) {
+				t.Errorf("%s has unknown state class %q", name, sc)
+			}
+		}
+	}
+}
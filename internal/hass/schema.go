@@ -0,0 +1,232 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hass describes what Home Assistant accepts in MQTT discovery
+// payloads, so that payloads can be checked before they are published.
+package hass
+
+import (
+	_ "embed"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/spontus/hass-crds/internal/jinja"
+)
+
+// KeyType is the type of the value of a discovery payload key.
+type KeyType string
+
+const (
+	TypeString  KeyType = "string"
+	TypeBoolean KeyType = "boolean"
+	TypeInteger KeyType = "integer"
+	TypeNumber  KeyType = "number"
+	TypeList    KeyType = "list"
+	TypeObject  KeyType = "object"
+)
+
+// Group is a set of mutually exclusive keys.
+type Group struct {
+	Keys []string `json:"keys"`
+	// Required means exactly one of the keys must be set.
+	Required bool `json:"required,omitempty"`
+}
+
+// Component is the discovery schema of a Home Assistant component.
+type Component struct {
+	Name string `json:"name"`
+	// Entity is false for components that do not create an entity, such as
+	// tags and device triggers.
+	Entity    bool               `json:"entity"`
+	Keys      map[string]KeyType `json:"keys"`
+	Required  []string           `json:"required,omitempty"`
+	Exclusive []Group            `json:"exclusive,omitempty"`
+}
+
+// section is a set of keys in components.yaml.
+type section struct {
+	Entity    *bool              `json:"entity,omitempty"`
+	Keys      map[string]KeyType `json:"keys"`
+	Required  []string           `json:"required,omitempty"`
+	Exclusive []Group            `json:"exclusive,omitempty"`
+}
+
+//go:embed components.yaml
+var componentsYAML []byte
+
+var components = mustLoad(componentsYAML)
+
+// mustLoad parses the component table, adding the keys shared by all
+// components and by all entity components to each of them.
+func mustLoad(data []byte) map[string]*Component {
+	var table struct {
+		Discovery  section             `json:"discovery"`
+		Entity     section             `json:"entity"`
+		Components map[string]*section `json:"components"`
+	}
+	if err := yaml.UnmarshalStrict(data, &table); err != nil {
+		panic(fmt.Sprintf("hass: parsing components.yaml: %v", err))
+	}
+
+	result := make(map[string]*Component, len(table.Components))
+	for name, s := range table.Components {
+		c := &Component{
+			Name:     name,
+			Entity:   s.Entity == nil || *s.Entity,
+			Keys:     make(map[string]KeyType),
+			Required: s.Required,
+		}
+		shared := []section{table.Discovery, *s}
+		if c.Entity {
+			shared = append(shared, table.Entity)
+		}
+		for _, sec := range shared {
+			for key, typ := range sec.Keys {
+				c.Keys[key] = typ
+			}
+			c.Exclusive = append(c.Exclusive, sec.Exclusive...)
+		}
+		result[name] = c
+	}
+	return result
+}
+
+// Lookup returns the schema of component, or nil if it is not known.
+func Lookup(component string) *Component {
+	return components[component]
+}
+
+// Components returns the schemas of all components, ordered by name.
+func Components() []*Component {
+	result := make([]*Component, 0, len(components))
+	for _, c := range components {
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Accepts reports whether key is part of the component's discovery payload.
+func (c *Component) Accepts(key string) bool {
+	_, ok := c.Keys[key]
+	return ok
+}
+
+// ValidationError lists the problems found in a discovery payload.
+type ValidationError struct {
+	Component string
+	Problems  []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s discovery payload: %s", e.Component, strings.Join(e.Problems, "; "))
+}
+
+// Validate checks payload against the component's schema. It returns a
+// *ValidationError listing every unknown key, value of the wrong type,
+// template with invalid syntax, missing required key and violated exclusive
+// group. Keys listed in extra, set by hand for options newer than the schema,
+// may be unknown; their values are checked when the key is known.
+func (c *Component) Validate(payload map[string]interface{}, extra ...string) error {
+	var problems []string
+
+	keys := make([]string, 0, len(payload))
+	for key := range payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		typ, ok := c.Keys[key]
+		if !ok && slices.Contains(extra, key) {
+			continue
+		}
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s is not accepted", key))
+			continue
+		}
+		if !typ.Matches(payload[key]) {
+			problems = append(problems, fmt.Sprintf("%s must be of type %s", key, typ))
+			continue
+		}
+		if s, ok := payload[key].(string); ok && IsTemplate(key) {
+			if err := jinja.Check(s); err != nil {
+				problems = append(problems, fmt.Sprintf("%s is not a valid template: %v", key, err))
+			}
+		}
+	}
+
+	for _, key := range c.Required {
+		if _, ok := payload[key]; !ok {
+			problems = append(problems, fmt.Sprintf("%s is required", key))
+		}
+	}
+
+	for _, g := range c.Exclusive {
+		var set []string
+		for _, key := range g.Keys {
+			if _, ok := payload[key]; ok {
+				set = append(set, key)
+			}
+		}
+		switch {
+		case len(set) > 1:
+			problems = append(problems, fmt.Sprintf("only one of %s may be set", strings.Join(g.Keys, ", ")))
+		case len(set) == 0 && g.Required:
+			problems = append(problems, fmt.Sprintf("one of %s is required", strings.Join(g.Keys, ", ")))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Component: c.Name, Problems: problems}
+	}
+	return nil
+}
+
+// IsTemplate reports whether the value of a discovery payload key is a
+// template Home Assistant renders.
+func IsTemplate(key string) bool {
+	return strings.HasSuffix(key, "_template")
+}
+
+// Matches reports whether value, as built by payload.Builder or decoded from
+// JSON, is of type t.
+func (t KeyType) Matches(value interface{}) bool {
+	v := reflect.ValueOf(value)
+	switch t {
+	case TypeString:
+		return v.Kind() == reflect.String
+	case TypeBoolean:
+		return v.Kind() == reflect.Bool
+	case TypeInteger:
+		if v.CanFloat() {
+			f := v.Float()
+			return f == float64(int64(f))
+		}
+		return v.CanInt() || v.CanUint()
+	case TypeNumber:
+		return v.CanInt() || v.CanUint() || v.CanFloat()
+	case TypeList:
+		return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+	case TypeObject:
+		return v.Kind() == reflect.Map
+	}
+	return false
+}
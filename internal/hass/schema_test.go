@@ -0,0 +1,173 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hass
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestComponents(t *testing.T) {
+	for _, c := range Components() {
+		t.Run(c.Name, func(t *testing.T) {
+			if Lookup(c.Name) != c {
+				t.Errorf("Lookup(%q) did not return the component", c.Name)
+			}
+			if !c.Accepts("origin") {
+				t.Error("origin is not accepted")
+			}
+			if c.Accepts("unique_id") != c.Entity {
+				t.Errorf("Accepts(unique_id) = %v, want %v", c.Accepts("unique_id"), c.Entity)
+			}
+			for key, typ := range c.Keys {
+				if !validType(typ) {
+					t.Errorf("%s has unknown type %q", key, typ)
+				}
+			}
+			for _, key := range c.Required {
+				if !c.Accepts(key) {
+					t.Errorf("required key %s is not accepted", key)
+				}
+			}
+			for _, g := range c.Exclusive {
+				for _, key := range g.Keys {
+					if !c.Accepts(key) {
+						t.Errorf("exclusive key %s is not accepted", key)
+					}
+				}
+			}
+		})
+	}
+
+	if Lookup("unknown") != nil {
+		t.Error("Lookup(unknown) found a component")
+	}
+}
+
+func validType(t KeyType) bool {
+	switch t {
+	case TypeString, TypeBoolean, TypeInteger, TypeNumber, TypeList, TypeObject:
+		return true
+	}
+	return false
+}
+
+func TestComponent_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		component string
+		payload   map[string]interface{}
+		extra     []string
+		problems  []string
+	}{
+		{
+			name:      "valid",
+			component: "switch",
+			payload: map[string]interface{}{
+				"command_topic": "porch/set",
+				"retain":        true,
+				"qos":           1,
+				"device":        map[string]interface{}{"name": "Porch"},
+				"origin":        map[string]interface{}{"name": "hass-crds"},
+			},
+		},
+		{
+			name:      "JSON numbers",
+			component: "number",
+			payload:   map[string]interface{}{"command_topic": "t", "min": float64(1.5), "qos": float64(1)},
+		},
+		{
+			name:      "unknown key and wrong type",
+			component: "switch",
+			payload:   map[string]interface{}{"command_topic": "t", "colour": "red", "qos": "1"},
+			problems:  []string{"colour is not accepted", "qos must be of type integer"},
+		},
+		{
+			name:      "fractional integer",
+			component: "switch",
+			payload:   map[string]interface{}{"command_topic": "t", "qos": 1.5},
+			problems:  []string{"qos must be of type integer"},
+		},
+		{
+			name:      "missing required key",
+			component: "sensor",
+			payload:   map[string]interface{}{"name": "Temperature"},
+			problems:  []string{"state_topic is required"},
+		},
+		{
+			name:      "exclusive keys",
+			component: "sensor",
+			payload: map[string]interface{}{
+				"state_topic":        "t",
+				"availability_topic": "a",
+				"availability":       []map[string]interface{}{{"topic": "a"}},
+			},
+			problems: []string{"only one of availability, availability_topic may be set"},
+		},
+		{
+			name:      "required exclusive group",
+			component: "image",
+			payload:   map[string]interface{}{},
+			problems:  []string{"one of image_topic, url_topic is required"},
+		},
+		{
+			name:      "unknown extra keys",
+			component: "light",
+			payload:   map[string]interface{}{"command_topic": "t", "future_option": 100, "qos": "high", "colour": "red"},
+			extra:     []string{"future_option", "qos"},
+			problems:  []string{"colour is not accepted", "qos must be of type integer"},
+		},
+		{
+			name:      "template syntax",
+			component: "sensor",
+			payload: map[string]interface{}{
+				"state_topic":    "t",
+				"value_template": "{{ value_json.temperature | round(1) }",
+			},
+			problems: []string{"value_template is not a valid template: line 1: unexpected end of template, expected '}}'"},
+		},
+		{
+			name:      "entity keys on a device trigger",
+			component: "device_automation",
+			payload: map[string]interface{}{
+				"automation_type": "trigger", "topic": "t", "type": "button_short_press", "subtype": "button_1",
+				"device": map[string]interface{}{"identifiers": []string{"d1"}}, "unique_id": "x",
+			},
+			problems: []string{"unique_id is not accepted"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Lookup(tt.component).Validate(tt.payload, tt.extra...)
+			if tt.problems == nil {
+				if err != nil {
+					t.Fatalf("Validate() error: %v", err)
+				}
+				return
+			}
+			var invalid *ValidationError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("Validate() = %v, want a ValidationError", err)
+			}
+			if invalid.Component != tt.component || !reflect.DeepEqual(invalid.Problems, tt.problems) {
+				t.Errorf("Validate() = %+v, want problems %q", invalid, tt.problems)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hass
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Version is a Home Assistant release, e.g. 2025.10. The zero Version
+// targets no release in particular.
+type Version struct {
+	Year  int
+	Month int
+}
+
+// ParseVersion parses a release such as 2025.10 or 2025.10.3. The patch
+// release is ignored, as discovery keys only change in monthly releases. An
+// empty string is the zero Version.
+func ParseVersion(s string) (Version, error) {
+	if s == "" {
+		return Version{}, nil
+	}
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) < 2 {
+		return Version{}, fmt.Errorf("invalid Home Assistant version %q, want e.g. 2025.10", s)
+	}
+	year, err := strconv.Atoi(parts[0])
+	if err != nil || year < 2000 {
+		return Version{}, fmt.Errorf("invalid Home Assistant version %q, want e.g. 2025.10", s)
+	}
+	month, err := strconv.Atoi(parts[1])
+	if err != nil || month < 1 || month > 12 {
+		return Version{}, fmt.Errorf("invalid Home Assistant version %q, want e.g. 2025.10", s)
+	}
+	return Version{Year: year, Month: month}, nil
+}
+
+// IsZero reports whether v is the zero Version.
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// AtLeast reports whether v is release o or a later one.
+func (v Version) AtLeast(o Version) bool {
+	return v.Year > o.Year || v.Year == o.Year && v.Month >= o.Month
+}
+
+func (v Version) String() string {
+	if v.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d", v.Year, v.Month)
+}
+
+// UnmarshalJSON parses a Version from a string such as "2025.10".
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseVersion(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Deprecation is a set of discovery keys Home Assistant deprecated.
+type Deprecation struct {
+	Keys []string `json:"keys"`
+	// Components the keys are deprecated for, or all if empty.
+	Components []string `json:"components,omitempty"`
+	Deprecated Version  `json:"deprecated"`
+	// Removed is the release that no longer accepts the keys, or zero if
+	// their removal is not scheduled.
+	Removed Version `json:"removed,omitempty"`
+	// Replacement is the key that replaces a single deprecated key.
+	Replacement string `json:"replacement,omitempty"`
+	// EntityID means the replacement takes an entity ID rather than an
+	// object ID.
+	EntityID bool   `json:"entity_id,omitempty"`
+	Note     string `json:"note,omitempty"`
+}
+
+//go:embed deprecations.yaml
+var deprecationsYAML []byte
+
+var deprecations = mustLoadDeprecations(deprecationsYAML)
+
+func mustLoadDeprecations(data []byte) []Deprecation {
+	var table struct {
+		Deprecations []Deprecation `json:"deprecations"`
+	}
+	if err := yaml.UnmarshalStrict(data, &table); err != nil {
+		panic(fmt.Sprintf("hass: parsing deprecations.yaml: %v", err))
+	}
+	return table.Deprecations
+}
+
+// Deprecations returns the table of deprecated discovery keys.
+func Deprecations() []Deprecation {
+	return deprecations
+}
+
+// DeprecatedKey is a deprecated key Upgrade found in a payload.
+type DeprecatedKey struct {
+	Key string
+	// Removed is true if the targeted release no longer accepts the key.
+	Removed bool
+	// Message says what became of the key.
+	Message string
+}
+
+// Upgrade rewrites payload, a discovery payload of component, for Home
+// Assistant release target or later: deprecated keys are rewritten to their
+// replacement, and keys without one are left out once target no longer
+// accepts them. It returns the deprecated keys payload had, sorted. A zero
+// target leaves payload as it is.
+func Upgrade(target Version, component string, payload map[string]interface{}) []DeprecatedKey {
+	if target.IsZero() {
+		return nil
+	}
+
+	var found []DeprecatedKey
+	for _, d := range deprecations {
+		if !target.AtLeast(d.Deprecated) {
+			continue
+		}
+		if len(d.Components) > 0 && !slices.Contains(d.Components, component) {
+			continue
+		}
+		for _, key := range d.Keys {
+			value, ok := payload[key]
+			if !ok {
+				continue
+			}
+			removed := !d.Removed.IsZero() && target.AtLeast(d.Removed)
+			found = append(found, DeprecatedKey{Key: key, Removed: removed, Message: d.message(key, removed)})
+
+			switch {
+			case d.Replacement != "":
+				if _, set := payload[d.Replacement]; !set {
+					payload[d.Replacement] = d.replace(component, value)
+				}
+				delete(payload, key)
+			case removed:
+				delete(payload, key)
+			}
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Key < found[j].Key })
+	return found
+}
+
+// replace returns the value of the replacement key for value.
+func (d *Deprecation) replace(component string, value interface{}) interface{} {
+	if s, ok := value.(string); ok && d.EntityID && !strings.Contains(s, ".") {
+		return component + "." + s
+	}
+	return value
+}
+
+// message says what Upgrade did with key.
+func (d *Deprecation) message(key string, removed bool) string {
+	var msg string
+	switch {
+	case d.Replacement != "" && removed:
+		msg = fmt.Sprintf("%s was removed in %s and is rewritten to %s", key, d.Removed, d.Replacement)
+	case d.Replacement != "":
+		msg = fmt.Sprintf("%s is deprecated since %s and is rewritten to %s", key, d.Deprecated, d.Replacement)
+	case removed:
+		msg = fmt.Sprintf("%s was removed in %s and is left out", key, d.Removed)
+	case !d.Removed.IsZero():
+		msg = fmt.Sprintf("%s is deprecated since %s and removed in %s", key, d.Deprecated, d.Removed)
+	default:
+		msg = fmt.Sprintf("%s is deprecated since %s", key, d.Deprecated)
+	}
+	if d.Note != "" {
+		msg += ", " + d.Note
+	}
+	return msg
+}
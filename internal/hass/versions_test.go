@@ -0,0 +1,172 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hass
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Version
+		wantErr bool
+	}{
+		{in: "", want: Version{}},
+		{in: "2025.10", want: Version{Year: 2025, Month: 10}},
+		{in: "2025.10.3", want: Version{Year: 2025, Month: 10}},
+		{in: "2024.1.0b2", want: Version{Year: 2024, Month: 1}},
+		{in: "2025", wantErr: true},
+		{in: "2025.13", wantErr: true},
+		{in: "v2025.10", wantErr: true},
+		{in: "0.118", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseVersion(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	v := Version{Year: 2025, Month: 10}
+	if !v.AtLeast(Version{Year: 2025, Month: 10}) || !v.AtLeast(Version{Year: 2024, Month: 12}) || v.AtLeast(Version{Year: 2026, Month: 1}) {
+		t.Error("AtLeast() does not order releases by year and month")
+	}
+	if v.String() != "2025.10" {
+		t.Errorf("String() = %q, want 2025.10", v.String())
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		component string
+		payload   map[string]interface{}
+		want      map[string]interface{}
+		wantKeys  []DeprecatedKey
+	}{
+		{
+			name:      "no target",
+			component: "sensor",
+			payload:   map[string]interface{}{"object_id": "temp"},
+			want:      map[string]interface{}{"object_id": "temp"},
+		},
+		{
+			name:      "before deprecation",
+			target:    "2025.9",
+			component: "sensor",
+			payload:   map[string]interface{}{"object_id": "temp"},
+			want:      map[string]interface{}{"object_id": "temp"},
+		},
+		{
+			name:      "rewritten to entity ID",
+			target:    "2025.10",
+			component: "sensor",
+			payload:   map[string]interface{}{"object_id": "temp"},
+			want:      map[string]interface{}{"default_entity_id": "sensor.temp"},
+			wantKeys: []DeprecatedKey{
+				{Key: "object_id", Message: "object_id is deprecated since 2025.10 and is rewritten to default_entity_id"},
+			},
+		},
+		{
+			name:      "replacement already set",
+			target:    "2026.4",
+			component: "sensor",
+			payload:   map[string]interface{}{"object_id": "temp", "default_entity_id": "sensor.other"},
+			want:      map[string]interface{}{"default_entity_id": "sensor.other"},
+			wantKeys: []DeprecatedKey{
+				{Key: "object_id", Removed: true, Message: "object_id was removed in 2026.4 and is rewritten to default_entity_id"},
+			},
+		},
+		{
+			name:      "deprecated and kept",
+			target:    "2024.6",
+			component: "light",
+			payload:   map[string]interface{}{"color_temp": true, "brightness": true},
+			want:      map[string]interface{}{"color_temp": true, "brightness": true},
+			wantKeys: []DeprecatedKey{
+				{Key: "color_temp", Message: "color_temp is deprecated since 2024.4 and removed in 2025.3, list the color modes in supported_color_modes instead"},
+			},
+		},
+		{
+			name:      "removed and left out",
+			target:    "2025.3",
+			component: "light",
+			payload:   map[string]interface{}{"color_temp": true, "brightness": true},
+			want:      map[string]interface{}{"brightness": true},
+			wantKeys: []DeprecatedKey{
+				{Key: "color_temp", Removed: true, Message: "color_temp was removed in 2025.3 and is left out, list the color modes in supported_color_modes instead"},
+			},
+		},
+		{
+			name:      "other component",
+			target:    "2025.3",
+			component: "climate",
+			payload:   map[string]interface{}{"hs": true},
+			want:      map[string]interface{}{"hs": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := ParseVersion(tt.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := Upgrade(target, tt.component, tt.payload)
+			if !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Upgrade() = %+v, want %+v", got, tt.wantKeys)
+			}
+			if !reflect.DeepEqual(tt.payload, tt.want) {
+				t.Errorf("payload = %v, want %v", tt.payload, tt.want)
+			}
+		})
+	}
+}
+
+// TestDeprecations checks that the table only names known components and
+// that replacements are accepted by them.
+func TestDeprecations(t *testing.T) {
+	for _, d := range Deprecations() {
+		if d.Deprecated.IsZero() || !d.Removed.IsZero() && !d.Removed.AtLeast(d.Deprecated) {
+			t.Errorf("%v: removed %v before deprecated %v", d.Keys, d.Removed, d.Deprecated)
+		}
+		if d.Replacement != "" && len(d.Keys) != 1 {
+			t.Errorf("%v: a replacement replaces a single key", d.Keys)
+		}
+		components := d.Components
+		if len(components) == 0 {
+			components = []string{"sensor"}
+		}
+		for _, name := range components {
+			c := Lookup(name)
+			if c == nil {
+				t.Errorf("%v: unknown component %q", d.Keys, name)
+				continue
+			}
+			if d.Replacement != "" && !c.Accepts(d.Replacement) {
+				t.Errorf("%v: %s does not accept replacement %q", d.Keys, name, d.Replacement)
+			}
+		}
+	}
+}
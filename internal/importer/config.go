@@ -0,0 +1,355 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/topic"
+)
+
+// ParseConfig converts the MQTT entities of Home Assistant YAML configuration
+// into resources in namespace. It accepts a configuration.yaml with an `mqtt:`
+// section, either a mapping of platforms or a list of them, the legacy style
+// of `platform: mqtt` entries under each platform key, and the content of a
+// file included under `mqtt:`.
+//
+// Device blocks shared by several entities are extracted into MQTTDevice
+// resources referenced by deviceRef. Values using tags such as !secret or
+// !include cannot be resolved and are reported as warnings.
+func ParseConfig(data []byte, namespace string) (*Result, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing YAML: %w", err)
+	}
+
+	p := &configParser{
+		namespace: namespace,
+		result:    &Result{Candidates: []*Candidate{}},
+		names:     make(map[string]bool),
+	}
+	if len(doc.Content) == 0 {
+		return p.result, nil
+	}
+
+	root := doc.Content[0]
+	mqttSection := mappingValue(root, "mqtt")
+	if mqttSection != nil {
+		p.section(mqttSection, "mqtt", false)
+	}
+	// Without an mqtt key the document may be a file included under it,
+	// whose entries have no platform key
+	p.section(root, "", mqttSection != nil)
+
+	p.extractDevices()
+	return p.result, nil
+}
+
+// configParser collects the candidates of a YAML configuration.
+type configParser struct {
+	namespace string
+	result    *Result
+	// names holds the kind/name pairs taken so far.
+	names map[string]bool
+}
+
+// section converts the platforms of a mapping such as `mqtt:`, or of a list
+// of such mappings. With legacy set only `platform: mqtt` entries are taken.
+func (p *configParser) section(node *yaml.Node, path string, legacy bool) {
+	if isCustomTag(node.Tag) {
+		p.skip(path, fmt.Sprintf("%s %s is not resolved; import that file directly", node.Tag, node.Value))
+		return
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			platform := node.Content[i].Value
+			kind, ok := topic.ComponentToKind[platform]
+			if !ok {
+				continue
+			}
+			p.platform(node.Content[i+1], kind, platform, joinPath(path, platform), legacy)
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			p.section(item, fmt.Sprintf("%s[%d]", path, i), legacy)
+		}
+	}
+}
+
+// platform converts the entries configured under a platform key, either a
+// list of entries or a single one.
+func (p *configParser) platform(node *yaml.Node, kind, platform, path string, legacy bool) {
+	if isCustomTag(node.Tag) {
+		p.skip(path, fmt.Sprintf("%s %s is not resolved; import that file directly", node.Tag, node.Value))
+		return
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		p.entry(node, kind, platform, path, legacy)
+		return
+	}
+	for i, item := range node.Content {
+		p.entry(item, kind, platform, fmt.Sprintf("%s[%d]", path, i), legacy)
+	}
+}
+
+// entry converts a single entity configuration. Entries of other
+// integrations' platforms are ignored.
+func (p *configParser) entry(node *yaml.Node, kind, platform, path string, legacy bool) {
+	if node.Kind != yaml.MappingNode {
+		p.skip(path, "entry is not a mapping")
+		return
+	}
+	if v := mappingValue(node, "platform"); v != nil {
+		if v.Value != "mqtt" {
+			return
+		}
+	} else if legacy {
+		return
+	}
+
+	warnings := stripCustomTags(node, "")
+
+	var value interface{}
+	if err := node.Decode(&value); err != nil {
+		p.skip(path, err.Error())
+		return
+	}
+	// Round-trip through JSON so values have the types Convert works with
+	data, err := json.Marshal(value)
+	if err != nil {
+		p.skip(path, err.Error())
+		return
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		p.skip(path, err.Error())
+		return
+	}
+
+	id, _ := config["unique_id"].(string)
+	if id == "" {
+		warnings = append(warnings, "unique_id: not set, Home Assistant will register the entity anew")
+		id, _ = config["name"].(string)
+	}
+	if id == "" {
+		id = platform
+	}
+
+	c, err := convert(kind, p.uniqueName(kind, resourceName(id)), p.namespace, config)
+	if err != nil {
+		p.skip(path, err.Error())
+		return
+	}
+	c.Source = path
+	c.Warnings = append(warnings, c.Warnings...)
+	p.result.Candidates = append(p.result.Candidates, c)
+}
+
+// skip records an entry that could not be converted.
+func (p *configParser) skip(path, reason string) {
+	p.result.Skipped = append(p.result.Skipped, Skipped{Source: path, Reason: reason})
+}
+
+// uniqueName returns name, suffixed with a number if a resource of kind
+// already has it.
+func (p *configParser) uniqueName(kind, name string) string {
+	unique := name
+	for i := 2; p.names[kind+"/"+unique]; i++ {
+		unique = fmt.Sprintf("%s-%d", name, i)
+	}
+	p.names[kind+"/"+unique] = true
+	return unique
+}
+
+// extractDevices replaces device blocks with the same identifiers, or the
+// same connections, used by several entities with a reference to an
+// MQTTDevice holding their merged fields. The devices are put before the
+// entities so they are created first.
+func (p *configParser) extractDevices() {
+	groups := make(map[string][]*Candidate)
+	var keys []string
+	for _, c := range p.result.Candidates {
+		field := deviceField(c)
+		if !field.IsValid() {
+			continue
+		}
+		key := deviceKey(field.Interface().(*mqttv1alpha1.DeviceBlock))
+		if key == "" {
+			continue
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], c)
+	}
+
+	var devices []*Candidate
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < 2 {
+			continue
+		}
+
+		merged := map[string]interface{}{}
+		for _, c := range group {
+			block, _ := toMap(deviceField(c).Interface())
+			for field, v := range block {
+				if existing, ok := merged[field]; !ok {
+					merged[field] = v
+				} else if !reflect.DeepEqual(existing, v) {
+					c.Warnings = append(c.Warnings, fmt.Sprintf("device.%s: differs from the other entities of the device, %v is used", field, existing))
+				}
+			}
+		}
+
+		device := &mqttv1alpha1.MQTTDevice{}
+		device.SetGroupVersionKind(mqttv1alpha1.GroupVersion.WithKind("MQTTDevice"))
+		data, _ := json.Marshal(merged)
+		_ = json.Unmarshal(data, &device.Spec)
+
+		id := device.Spec.Name
+		if id == "" && len(device.Spec.Identifiers) > 0 {
+			id = device.Spec.Identifiers[0]
+		}
+		if id == "" {
+			id = "device"
+		}
+		device.Name = p.uniqueName("MQTTDevice", resourceName(id))
+		device.Namespace = p.namespace
+
+		for _, c := range group {
+			deviceField(c).Set(reflect.ValueOf((*mqttv1alpha1.DeviceBlock)(nil)))
+			reflect.ValueOf(c.Object).Elem().FieldByName("Spec").FieldByName("DeviceRef").
+				Set(reflect.ValueOf(&mqttv1alpha1.DeviceRef{Name: device.Name}))
+		}
+
+		devices = append(devices, &Candidate{
+			Source:    group[0].Source + ".device",
+			Kind:      "MQTTDevice",
+			Namespace: p.namespace,
+			Name:      device.Name,
+			Object:    device,
+		})
+	}
+
+	p.result.Candidates = append(devices, p.result.Candidates...)
+}
+
+// deviceField returns the spec's device block field of c's resource.
+func deviceField(c *Candidate) reflect.Value {
+	return reflect.ValueOf(c.Object).Elem().FieldByName("Spec").FieldByName("Device")
+}
+
+// deviceKey identifies a device block the way Home Assistant matches devices:
+// by identifiers, or by connections without identifiers.
+func deviceKey(d *mqttv1alpha1.DeviceBlock) string {
+	switch {
+	case d == nil:
+		return ""
+	case len(d.Identifiers) > 0:
+		ids := append([]string(nil), d.Identifiers...)
+		sort.Strings(ids)
+		return "identifiers:" + strings.Join(ids, "\x00")
+	case len(d.Connections) > 0:
+		data, _ := json.Marshal(d.Connections)
+		return "connections:" + string(data)
+	default:
+		return ""
+	}
+}
+
+// toMap converts v to a map through JSON.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
+
+// mappingValue returns the value of key in a mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// stripCustomTags removes the values tagged with Home Assistant tags such as
+// !secret from node and returns a warning for each.
+func stripCustomTags(node *yaml.Node, path string) []string {
+	var warnings []string
+	switch node.Kind {
+	case yaml.MappingNode:
+		content := node.Content[:0]
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			keyPath := joinPath(path, key.Value)
+			if isCustomTag(value.Tag) {
+				warnings = append(warnings, fmt.Sprintf("%s: %s %s is not resolved", keyPath, value.Tag, value.Value))
+				continue
+			}
+			warnings = append(warnings, stripCustomTags(value, keyPath)...)
+			content = append(content, key, value)
+		}
+		node.Content = content
+	case yaml.SequenceNode:
+		content := node.Content[:0]
+		for i, item := range node.Content {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if isCustomTag(item.Tag) {
+				warnings = append(warnings, fmt.Sprintf("%s: %s %s is not resolved", itemPath, item.Tag, item.Value))
+				continue
+			}
+			warnings = append(warnings, stripCustomTags(item, itemPath)...)
+			content = append(content, item)
+		}
+		node.Content = content
+	}
+	return warnings
+}
+
+// isCustomTag reports whether tag is an application tag such as !secret,
+// rather than a standard YAML tag.
+func isCustomTag(tag string) bool {
+	return strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!")
+}
+
+// joinPath appends key to a dotted YAML path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
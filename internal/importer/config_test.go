@@ -0,0 +1,166 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+const testConfig = `homeassistant:
+  name: Home
+mqtt:
+  switch:
+    - name: Garage Door
+      unique_id: garage_door
+      command_topic: garage/door/set
+      payload_on: 1
+      device:
+        identifiers: garage-1
+        name: Garage
+    - name: Garage Light
+      unique_id: garage_light
+      command_topic: garage/light/set
+      device:
+        identifiers: [garage-1]
+        manufacturer: DIY
+  sensor:
+    name: Temp
+    state_topic: garage/temp
+    json_attributes_topic: !secret attributes_topic
+    bogus_key: 3
+    device:
+      identifiers: [shed-1]
+  light: !include lights.yaml
+sensor:
+  - platform: mqtt
+    name: Humidity
+    unique_id: hum
+    state_topic: garage/hum
+  - platform: template
+    sensors: {}
+`
+
+func TestParseConfig(t *testing.T) {
+	result, err := ParseConfig([]byte(testConfig), "home")
+	if err != nil {
+		t.Fatalf("ParseConfig() error: %v", err)
+	}
+
+	var got []string
+	for _, c := range result.Candidates {
+		got = append(got, c.Kind+"/"+c.Name+" "+c.Source)
+	}
+	want := []string{
+		"MQTTDevice/garage mqtt.switch[0].device",
+		"MQTTSwitch/garage-door mqtt.switch[0]",
+		"MQTTSwitch/garage-light mqtt.switch[1]",
+		"MQTTSensor/temp mqtt.sensor",
+		"MQTTSensor/hum sensor[0]",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("candidates =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if len(result.Skipped) != 1 || result.Skipped[0].Source != "mqtt.light" {
+		t.Errorf("Skipped = %+v, want the included lights", result.Skipped)
+	}
+
+	// The shared device is extracted with the fields of both blocks
+	device := result.Candidates[0].Object.(*mqttv1alpha1.MQTTDevice)
+	if device.Spec.Name != "Garage" || device.Spec.Manufacturer != "DIY" || len(device.Spec.Identifiers) != 1 {
+		t.Errorf("device spec = %+v", device.Spec)
+	}
+	door := result.Candidates[1].Object.(*mqttv1alpha1.MQTTSwitch)
+	if door.Spec.Device != nil || door.Spec.DeviceRef == nil || door.Spec.DeviceRef.Name != "garage" {
+		t.Errorf("door device = %+v, deviceRef = %+v, want a reference to garage", door.Spec.Device, door.Spec.DeviceRef)
+	}
+	if door.Spec.UniqueId != "garage_door" || door.Spec.PayloadOn != "1" {
+		t.Errorf("door spec = %+v", door.Spec)
+	}
+
+	// A device used by a single entity stays inline
+	temp := result.Candidates[3]
+	if sensor := temp.Object.(*mqttv1alpha1.MQTTSensor); sensor.Spec.Device == nil || sensor.Spec.DeviceRef != nil {
+		t.Errorf("sensor device = %+v, deviceRef = %+v, want the inline block", sensor.Spec.Device, sensor.Spec.DeviceRef)
+	}
+	warnings := strings.Join(temp.Warnings, "\n")
+	for _, w := range []string{"json_attributes_topic: !secret attributes_topic", "unique_id: not set", "bogus_key: not supported"} {
+		if !strings.Contains(warnings, w) {
+			t.Errorf("warnings %q do not contain %q", warnings, w)
+		}
+	}
+}
+
+func TestParseConfig_Styles(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{"list of platforms", "mqtt:\n  - button:\n      command_topic: a\n  - button:\n      - command_topic: b\n", 2},
+		{"included file", "button:\n  - command_topic: a\nswitch:\n  - command_topic: b\n", 2},
+		{"legacy without mqtt platform", "mqtt:\n  broker: localhost\nbutton:\n  - command_topic: a\n", 0},
+		{"empty", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseConfig([]byte(tt.input), "default")
+			if err != nil {
+				t.Fatalf("ParseConfig() error: %v", err)
+			}
+			if len(result.Candidates) != tt.want {
+				t.Errorf("got %d candidates, want %d", len(result.Candidates), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConfig_NameClash(t *testing.T) {
+	result, err := ParseConfig([]byte("mqtt:\n  button:\n    - name: Reboot\n      command_topic: a\n    - name: Reboot\n      command_topic: b\n"), "default")
+	if err != nil {
+		t.Fatalf("ParseConfig() error: %v", err)
+	}
+	if len(result.Candidates) != 2 || result.Candidates[0].Name != "reboot" || result.Candidates[1].Name != "reboot-2" {
+		t.Errorf("unexpected candidates %+v", result.Candidates)
+	}
+}
+
+func TestAdopt_FromConfig(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	result, err := ParseConfig([]byte("mqtt:\n  button:\n    unique_id: reboot\n    command_topic: a\n"), "default")
+	if err != nil {
+		t.Fatalf("ParseConfig() error: %v", err)
+	}
+	// Nothing is retained on the broker, so no MQTT client is needed
+	if err := Adopt(context.Background(), k8sClient, nil, result.Candidates[0]); err != nil {
+		t.Fatalf("Adopt() error: %v", err)
+	}
+
+	var button mqttv1alpha1.MQTTButton
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "reboot"}, &button); err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+}
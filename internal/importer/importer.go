@@ -0,0 +1,396 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importer converts discovery configs published by other tools into
+// MQTT* resources, and optionally adopts them so hass-crds manages them.
+package importer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/gc"
+	"github.com/spontus/hass-crds/internal/mqtt"
+	"github.com/spontus/hass-crds/internal/payload"
+	"github.com/spontus/hass-crds/internal/topic"
+)
+
+// DefaultSilenceTimeout is how long a scan waits for further retained messages.
+const DefaultSilenceTimeout = 5 * time.Second
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(mqttv1alpha1.AddToScheme(scheme))
+}
+
+// invalidNameChars matches runs of characters not allowed in resource names.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// Candidate is a discovery config converted to a resource.
+type Candidate struct {
+	// Topic is the discovery topic the config was found on.
+	Topic string `json:"topic,omitempty"`
+	// Source locates a config read from YAML configuration instead.
+	Source    string `json:"source,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Origin is the origin name of the config, if it has one.
+	Origin string `json:"origin,omitempty"`
+	// Warnings lists the keys that could not be carried over.
+	Warnings []string `json:"warnings,omitempty"`
+
+	// Object is the resource, with its spec filled from the config.
+	Object client.Object `json:"-"`
+}
+
+// Skipped is a discovery config that was not converted.
+type Skipped struct {
+	Topic  string `json:"topic,omitempty"`
+	Source string `json:"source,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// Result is the outcome of a broker scan.
+type Result struct {
+	Candidates []*Candidate `json:"candidates"`
+	Skipped    []Skipped    `json:"skipped,omitempty"`
+}
+
+// Discover scans the broker for retained discovery configs not published by
+// hass-crds and converts them into resources in namespace.
+func Discover(ctx context.Context, mqttClient mqtt.Client, namespace string, silence time.Duration) (*Result, error) {
+	entities, err := gc.ScanDiscovery(ctx, mqttClient, silence)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entities, func(i, j int) bool { return entities[i].Topic < entities[j].Topic })
+
+	result := &Result{Candidates: []*Candidate{}}
+	names := make(map[string]bool)
+	for _, e := range entities {
+		if len(e.Payload) == 0 {
+			continue
+		}
+
+		c, err := Convert(e.Topic, e.Payload, namespace)
+		if err != nil {
+			result.Skipped = append(result.Skipped, Skipped{Topic: e.Topic, Reason: err.Error()})
+			continue
+		}
+		if isOurs(c.Origin) {
+			continue
+		}
+
+		// Object IDs only need to be unique per node ID; qualify clashes with it
+		if names[c.Kind+"/"+c.Name] {
+			info, _ := topic.ParseDiscoveryTopic(e.Topic)
+			c.Name = resourceName(info.Namespace + "-" + info.Name)
+			c.Object.SetName(c.Name)
+		}
+		names[c.Kind+"/"+c.Name] = true
+
+		result.Candidates = append(result.Candidates, c)
+	}
+	return result, nil
+}
+
+// isOurs reports whether origin is the origin name of a hass-crds instance.
+func isOurs(origin string) bool {
+	return origin == payload.OriginName || strings.HasPrefix(origin, payload.OriginName+"/")
+}
+
+// Convert turns the discovery config data found on discoveryTopic into a
+// resource in namespace. Abbreviated keys and the "~" base topic are
+// expanded, keys are converted to camelCase and decoded into the spec of the
+// kind matching the topic's component. Keys the spec has no field for, or
+// whose value does not fit the field, are reported as warnings.
+func Convert(discoveryTopic string, data []byte, namespace string) (*Candidate, error) {
+	info, err := topic.ParseDiscoveryTopic(discoveryTopic)
+	if err != nil {
+		return nil, err
+	}
+	kind, ok := topic.ComponentToKind[info.Component]
+	if !ok {
+		return nil, fmt.Errorf("unsupported component %q", info.Component)
+	}
+
+	var config map[string]interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("config is not a JSON object: %w", err)
+	}
+	expand(config)
+
+	origin, _ := config["origin"].(map[string]interface{})
+	delete(config, "origin")
+
+	c, err := convert(kind, resourceName(info.Name), namespace, config)
+	if err != nil {
+		return nil, err
+	}
+	c.Topic = discoveryTopic
+	if origin != nil {
+		c.Origin, _ = origin["name"].(string)
+	}
+	return c, nil
+}
+
+// convert decodes the expanded config into a new resource of kind, reporting
+// the keys that could not be decoded as warnings.
+func convert(kind, name, namespace string, config map[string]interface{}) (*Candidate, error) {
+	obj, err := scheme.New(mqttv1alpha1.GroupVersion.WithKind(kind))
+	if err != nil {
+		return nil, err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(mqttv1alpha1.GroupVersion.WithKind(kind))
+	object := obj.(client.Object)
+	object.SetNamespace(namespace)
+	object.SetName(name)
+
+	c := &Candidate{
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+		Object:    object,
+	}
+	delete(config, "platform")
+
+	normalize(config)
+
+	spec := reflect.ValueOf(object).Elem().FieldByName("Spec").Addr().Interface()
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := decodeKey(spec, k, config[k]); err != nil {
+			c.Warnings = append(c.Warnings, fmt.Sprintf("%s: %v", k, err))
+		}
+	}
+	return c, nil
+}
+
+// normalize rewrites config shapes Home Assistant accepts but the resources
+// do not: a single availability topic with custom payloads becomes an
+// availability list, and a single device identifier becomes a list.
+func normalize(config map[string]interface{}) {
+	if t, ok := config["availability_topic"]; ok {
+		entry := map[string]interface{}{"topic": t}
+		for from, to := range map[string]string{
+			"payload_available":     "payload_available",
+			"payload_not_available": "payload_not_available",
+			"availability_template": "value_template",
+		} {
+			if v, ok := config[from]; ok {
+				entry[to] = v
+			}
+		}
+		if len(entry) > 1 {
+			if _, ok := config["availability"]; !ok {
+				config["availability"] = []interface{}{entry}
+				delete(config, "availability_topic")
+				delete(config, "payload_available")
+				delete(config, "payload_not_available")
+				delete(config, "availability_template")
+			}
+		}
+	}
+
+	if device, ok := config["device"].(map[string]interface{}); ok {
+		if id, ok := device["identifiers"].(string); ok {
+			device["identifiers"] = []interface{}{id}
+		}
+	}
+}
+
+// decodeKey decodes the config key with value into spec. Scalars are
+// converted to strings where the field is a string, since Home Assistant
+// accepts e.g. numeric payloads.
+func decodeKey(spec interface{}, key string, value interface{}) error {
+	err := decodeField(spec, snakeToCamel(key), camelKeys(value))
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Type.Kind() == reflect.String {
+		switch v := value.(type) {
+		case bool, float64:
+			return decodeField(spec, snakeToCamel(key), fmt.Sprint(v))
+		}
+	}
+	return err
+}
+
+// decodeField decodes {key: value} into spec, rejecting unknown fields.
+func decodeField(spec interface{}, key string, value interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{key: value})
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(spec)
+
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &typeErr):
+		return err
+	case strings.HasPrefix(err.Error(), "json: unknown field"):
+		return errors.New("not supported by this kind")
+	default:
+		return err
+	}
+}
+
+// camelKeys converts the keys of nested objects, such as the device block,
+// to camelCase.
+func camelKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[snakeToCamel(k)] = camelKeys(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = camelKeys(item)
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// snakeToCamel converts a snake_case key to camelCase.
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// resourceName turns a discovery object ID into a valid resource name.
+func resourceName(id string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(id), "-")
+	name = strings.Trim(name, "-.")
+	if len(name) > 253 {
+		name = strings.TrimRight(name[:253], "-.")
+	}
+	if name == "" {
+		name = "imported"
+	}
+	return name
+}
+
+// Manifest returns the resource as an unstructured object without status.
+func (c *Candidate) Manifest() (map[string]interface{}, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(c.Object)
+	if err != nil {
+		return nil, err
+	}
+	delete(m, "status")
+	unstructured.RemoveNestedField(m, "metadata", "creationTimestamp")
+	return m, nil
+}
+
+// WriteYAML writes the candidates as a multi-document YAML stream. Each
+// document is preceded by comments naming its source topic and warnings.
+func WriteYAML(w io.Writer, candidates []*Candidate) error {
+	for _, c := range candidates {
+		m, err := c.Manifest()
+		if err != nil {
+			return err
+		}
+		data, err := yaml.Marshal(m)
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		buf.WriteString("---\n")
+		from := c.Topic
+		if from == "" {
+			from = c.Source
+		}
+		fmt.Fprintf(&buf, "# Imported from %s\n", from)
+		for _, warning := range c.Warnings {
+			fmt.Fprintf(&buf, "# Warning: %s\n", warning)
+		}
+		buf.Write(data)
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Adopt creates the resource for c and clears the original retained config,
+// so the controller republishes it under its own topic and origin. The unique
+// ID is carried over, so Home Assistant keeps the entity's registry entry.
+//
+// The resource is validated with a dry run first, and the old config is
+// cleared before the resource is created: Home Assistant ignores a config
+// whose unique ID is still claimed by another discovery topic. Candidates
+// read from YAML configuration have no retained config, and mqttClient may be
+// nil when only those are adopted.
+func Adopt(ctx context.Context, k8sClient client.Client, mqttClient mqtt.Client, c *Candidate) error {
+	existing := c.Object.DeepCopyObject().(client.Object)
+	err := k8sClient.Get(ctx, client.ObjectKeyFromObject(c.Object), existing)
+	switch {
+	case err == nil:
+		return fmt.Errorf("%s %s/%s already exists", c.Kind, c.Namespace, c.Name)
+	case !apierrors.IsNotFound(err):
+		return fmt.Errorf("checking for %s %s/%s: %w", c.Kind, c.Namespace, c.Name, err)
+	}
+
+	if err := k8sClient.Create(ctx, c.Object.DeepCopyObject().(client.Object), client.DryRunAll); err != nil {
+		return fmt.Errorf("validating %s %s/%s: %w", c.Kind, c.Namespace, c.Name, err)
+	}
+	if c.Topic != "" {
+		if err := mqttClient.Publish(ctx, c.Topic, []byte{}, 1, true); err != nil {
+			return fmt.Errorf("clearing %s: %w", c.Topic, err)
+		}
+	}
+	if err := k8sClient.Create(ctx, c.Object.DeepCopyObject().(client.Object)); err != nil {
+		return fmt.Errorf("creating %s %s/%s: %w", c.Kind, c.Namespace, c.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/mqtt"
+)
+
+func TestConvert_Switch(t *testing.T) {
+	data := []byte(`{
+		"~": "garage/door",
+		"name": "Garage door",
+		"uniq_id": "garage_door_1",
+		"cmd_t": "~/set",
+		"stat_t": "~/state",
+		"pl_on": 1,
+		"pl_off": false,
+		"opt": true,
+		"dev": {"ids": "garage-1", "mf": "Acme", "mdl_id": "GD-1"},
+		"avty_t": "garage/status",
+		"pl_avail": "up",
+		"o": {"name": "my-script"},
+		"platform": "mqtt",
+		"mystery": "x"
+	}`)
+
+	c, err := Convert("homeassistant/switch/garage/Door_Switch/config", data, "home")
+	if err != nil {
+		t.Fatalf("Convert() error: %v", err)
+	}
+	if c.Kind != "MQTTSwitch" || c.Name != "door-switch" || c.Namespace != "home" || c.Origin != "my-script" {
+		t.Errorf("Convert() = %s %s/%s origin %q, want MQTTSwitch home/door-switch origin my-script", c.Kind, c.Namespace, c.Name, c.Origin)
+	}
+
+	sw := c.Object.(*mqttv1alpha1.MQTTSwitch)
+	spec := sw.Spec
+	if spec.CommandTopic != "garage/door/set" || spec.StateTopic != "garage/door/state" {
+		t.Errorf("topics = %q, %q, want base topic expanded", spec.CommandTopic, spec.StateTopic)
+	}
+	if spec.UniqueId != "garage_door_1" || spec.Name != "Garage door" {
+		t.Errorf("uniqueId, name = %q, %q", spec.UniqueId, spec.Name)
+	}
+	if spec.PayloadOn != "1" || spec.PayloadOff != "false" {
+		t.Errorf("payloads = %q, %q, want scalars converted to strings", spec.PayloadOn, spec.PayloadOff)
+	}
+	if spec.Optimistic == nil || !*spec.Optimistic {
+		t.Errorf("optimistic = %v, want true", spec.Optimistic)
+	}
+	if spec.Device == nil || spec.Device.Manufacturer != "Acme" || spec.Device.ModelId != "GD-1" || len(spec.Device.Identifiers) != 1 {
+		t.Errorf("device = %+v", spec.Device)
+	}
+	if len(spec.Availability) != 1 || spec.Availability[0].Topic != "garage/status" || spec.Availability[0].PayloadAvailable != "up" {
+		t.Errorf("availability = %+v, want one entry with custom payload", spec.Availability)
+	}
+	if len(c.Warnings) != 1 || !strings.HasPrefix(c.Warnings[0], "mystery:") {
+		t.Errorf("warnings = %v, want only mystery", c.Warnings)
+	}
+}
+
+func TestConvert_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		topic string
+		data  string
+	}{
+		{"invalid topic", "homeassistant/switch/config", `{}`},
+		{"unsupported component", "homeassistant/media_player/a/b/config", `{}`},
+		{"not JSON", "homeassistant/switch/a/b/config", `on`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Convert(tt.topic, []byte(tt.data), "default"); err == nil {
+				t.Error("Convert() succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := map[string]string{
+		"Door_Switch":   "door-switch",
+		"0x00158d0001":  "0x00158d0001",
+		"__living room": "living-room",
+		"___":           "imported",
+	}
+	for id, want := range tests {
+		if got := resourceName(id); got != want {
+			t.Errorf("resourceName(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	retained := map[string]string{
+		"homeassistant/sensor/a/temp/config":    `{"stat_t":"a/temp","unit_of_meas":"°C"}`,
+		"homeassistant/sensor/b/temp/config":    `{"stat_t":"b/temp"}`,
+		"homeassistant/sensor/default/x/config": `{"stat_t":"x","o":{"name":"hass-crds"}}`,
+		"homeassistant/sensor/default/y/config": `{"stat_t":"y","o":{"name":"hass-crds/shard-a"}}`,
+		"homeassistant/media_player/a/b/config": `{}`,
+		"homeassistant/sensor/a/removed/config": ``,
+	}
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		for topic, data := range retained {
+			mockClient.SimulateMessage(topic, []byte(data))
+		}
+	}()
+
+	result, err := Discover(context.Background(), mockClient, "default", 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Discover() error: %v", err)
+	}
+
+	var names []string
+	for _, c := range result.Candidates {
+		names = append(names, c.Name)
+	}
+	if strings.Join(names, ",") != "temp,b-temp" {
+		t.Errorf("candidates = %v, want [temp b-temp]", names)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].Topic != "homeassistant/media_player/a/b/config" {
+		t.Errorf("skipped = %+v, want the media_player config", result.Skipped)
+	}
+	if unit := result.Candidates[0].Object.(*mqttv1alpha1.MQTTSensor).Spec.UnitOfMeasurement; unit != "°C" {
+		t.Errorf("unitOfMeasurement = %q, want °C", unit)
+	}
+}
+
+func TestAdopt(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	mockClient := mqtt.NewMockClient()
+	_ = mockClient.Connect(context.Background())
+
+	c, err := Convert("homeassistant/button/legacy/reboot/config", []byte(`{"cmd_t":"legacy/reboot","uniq_id":"reboot"}`), "default")
+	if err != nil {
+		t.Fatalf("Convert() error: %v", err)
+	}
+	if err := Adopt(context.Background(), k8sClient, mockClient, c); err != nil {
+		t.Fatalf("Adopt() error: %v", err)
+	}
+
+	msgs := mockClient.GetPublishedMessages()
+	if len(msgs) != 1 || msgs[0].Topic != c.Topic || len(msgs[0].Payload) != 0 || !msgs[0].Retain {
+		t.Errorf("published %+v, want the original config cleared", msgs)
+	}
+
+	var button mqttv1alpha1.MQTTButton
+	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "reboot"}, &button); err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	if button.Spec.UniqueId != "reboot" || button.Spec.CommandTopic != "legacy/reboot" {
+		t.Errorf("spec = %+v", button.Spec)
+	}
+
+	// An existing resource fails validation before the config is cleared
+	if err := Adopt(context.Background(), k8sClient, mockClient, c); err == nil {
+		t.Error("second Adopt() succeeded, want error")
+	}
+	if got := len(mockClient.GetPublishedMessages()); got != 1 {
+		t.Errorf("published %d messages, want 1", got)
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	c, err := Convert("homeassistant/button/legacy/reboot/config", []byte(`{"cmd_t":"legacy/reboot","bogus":1}`), "default")
+	if err != nil {
+		t.Fatalf("Convert() error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteYAML(&buf, []*Candidate{c}); err != nil {
+		t.Fatalf("WriteYAML() error: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"# Imported from homeassistant/button/legacy/reboot/config\n",
+		"# Warning: bogus: not supported by this kind\n",
+		"kind: MQTTButton\n",
+		"commandTopic: legacy/reboot\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("WriteYAML() output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "status") || strings.Contains(out, "creationTimestamp") {
+		t.Errorf("WriteYAML() output contains status or creationTimestamp:\n%s", out)
+	}
+}
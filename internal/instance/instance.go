@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instance identifies a controller installation so that several
+// installations, or shards of one, can share an MQTT broker without
+// touching each other's entities.
+package instance
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// Config holds the identity of this controller instance.
+type Config struct {
+	// ID distinguishes this instance's entities on the broker. It is empty
+	// for a single installation, which keeps the original origin name.
+	ID string
+
+	// Selector restricts the entity resources this instance owns. It is nil
+	// when the instance owns every resource.
+	Selector labels.Selector
+
+	// ClusterName is folded into unique IDs and discovery node IDs so that
+	// several clusters can publish to the same Home Assistant. It is empty
+	// for a single cluster, which keeps unique IDs unchanged.
+	ClusterName string
+}
+
+// NewConfigFromEnv creates a Config from environment variables.
+func NewConfigFromEnv() (*Config, error) {
+	cfg := &Config{
+		ID:          os.Getenv("INSTANCE_ID"),
+		ClusterName: os.Getenv("CLUSTER_NAME"),
+	}
+
+	if cfg.ClusterName != "" {
+		if errs := validation.IsDNS1123Label(cfg.ClusterName); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid CLUSTER_NAME %q: %s", cfg.ClusterName, strings.Join(errs, ", "))
+		}
+		// Clusters sharing a broker must not collect each other's entities
+		if cfg.ID == "" {
+			cfg.ID = cfg.ClusterName
+		}
+	}
+
+	if cfg.ID != "" {
+		if errs := validation.IsDNS1123Label(cfg.ID); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid INSTANCE_ID %q: %s", cfg.ID, strings.Join(errs, ", "))
+		}
+	}
+
+	if v := os.Getenv("INSTANCE_LABEL_SELECTOR"); v != "" {
+		selector, err := labels.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid INSTANCE_LABEL_SELECTOR %q: %w", v, err)
+		}
+		cfg.Selector = selector
+
+		// Shards on one broker must not collect each other's entities
+		if cfg.ID == "" {
+			return nil, fmt.Errorf("INSTANCE_LABEL_SELECTOR requires INSTANCE_ID")
+		}
+	}
+
+	return cfg, nil
+}
+
+// Name returns base qualified with the instance ID, for names that must not
+// collide between instances such as the leader election lease.
+func (c *Config) Name(base string) string {
+	if c.ID == "" {
+		return base
+	}
+	return c.ID + "-" + base
+}
+
+// CacheOptions restricts the manager's cache to the entity resources matched
+// by Selector. MQTTDevices are shared and always cached in full.
+func (c *Config) CacheOptions(scheme *runtime.Scheme) (cache.Options, error) {
+	if c.Selector == nil || c.Selector.Empty() {
+		return cache.Options{}, nil
+	}
+
+	byObject := make(map[client.Object]cache.ByObject, len(registry.Entities()))
+	for _, k := range registry.Entities() {
+		obj, err := scheme.New(k.GroupVersionKind())
+		if err != nil {
+			return cache.Options{}, fmt.Errorf("creating %s: %w", k.Kind, err)
+		}
+		byObject[obj.(client.Object)] = cache.ByObject{Label: c.Selector}
+	}
+	return cache.Options{ByObject: byObject}, nil
+}
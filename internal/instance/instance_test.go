@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+func TestNewConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          map[string]string
+		wantID       string
+		wantSelector string
+		wantErr      bool
+	}{
+		{name: "defaults"},
+		{name: "instance", env: map[string]string{"INSTANCE_ID": "office"}, wantID: "office"},
+		{name: "invalid instance", env: map[string]string{"INSTANCE_ID": "Office_1"}, wantErr: true},
+		{
+			name:         "shard",
+			env:          map[string]string{"INSTANCE_ID": "shard-a", "INSTANCE_LABEL_SELECTOR": "shard=a"},
+			wantID:       "shard-a",
+			wantSelector: "shard=a",
+		},
+		{name: "selector without instance", env: map[string]string{"INSTANCE_LABEL_SELECTOR": "shard=a"}, wantErr: true},
+		{name: "cluster name is the default instance", env: map[string]string{"CLUSTER_NAME": "office"}, wantID: "office"},
+		{name: "instance overrides cluster name", env: map[string]string{"CLUSTER_NAME": "office", "INSTANCE_ID": "shard-a"}, wantID: "shard-a"},
+		{name: "invalid cluster name", env: map[string]string{"CLUSTER_NAME": "Office"}, wantErr: true},
+		{name: "invalid selector", env: map[string]string{"INSTANCE_ID": "a", "INSTANCE_LABEL_SELECTOR": "shard in (a"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"INSTANCE_ID", "INSTANCE_LABEL_SELECTOR", "CLUSTER_NAME"} {
+				t.Setenv(key, "")
+			}
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			cfg, err := NewConfigFromEnv()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewConfigFromEnv() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if cfg.ID != tt.wantID {
+				t.Errorf("ID = %q, want %q", cfg.ID, tt.wantID)
+			}
+			gotSelector := ""
+			if cfg.Selector != nil {
+				gotSelector = cfg.Selector.String()
+			}
+			if gotSelector != tt.wantSelector {
+				t.Errorf("Selector = %q, want %q", gotSelector, tt.wantSelector)
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	if got := (&Config{}).Name("lease"); got != "lease" {
+		t.Errorf("Name() = %q, want lease", got)
+	}
+	if got := (&Config{ID: "office"}).Name("lease"); got != "office-lease" {
+		t.Errorf("Name() = %q, want office-lease", got)
+	}
+}
+
+func TestCacheOptions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = mqttv1alpha1.AddToScheme(scheme)
+
+	opts, err := (&Config{}).CacheOptions(scheme)
+	if err != nil || len(opts.ByObject) != 0 {
+		t.Fatalf("CacheOptions() without selector = %v, %v, want no restrictions", opts.ByObject, err)
+	}
+
+	t.Setenv("INSTANCE_ID", "shard-a")
+	t.Setenv("INSTANCE_LABEL_SELECTOR", "shard=a")
+	cfg, err := NewConfigFromEnv()
+	if err != nil {
+		t.Fatalf("NewConfigFromEnv() error: %v", err)
+	}
+
+	opts, err = cfg.CacheOptions(scheme)
+	if err != nil {
+		t.Fatalf("CacheOptions() error: %v", err)
+	}
+	if len(opts.ByObject) != len(registry.Entities()) {
+		t.Errorf("CacheOptions() restricts %d kinds, want %d", len(opts.ByObject), len(registry.Entities()))
+	}
+	for obj := range opts.ByObject {
+		if _, ok := obj.(*mqttv1alpha1.MQTTDevice); ok {
+			t.Error("MQTTDevice must not be restricted")
+		}
+	}
+}
@@ -0,0 +1,728 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jinja
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// maxSteps bounds the work of a single render, as templates come from
+// resources and API requests.
+const maxSteps = 100000
+
+// maxLength bounds the strings and lists a render can build.
+const maxLength = 1 << 20
+
+var errTooComplex = errors.New("template is too complex to render")
+
+type state struct {
+	scopes []map[string]interface{}
+	out    strings.Builder
+	steps  int
+}
+
+// Render renders the template with the given variables.
+func (t *Template) Render(vars map[string]interface{}) (string, error) {
+	s := &state{scopes: []map[string]interface{}{vars, {}}}
+	if err := s.execNodes(t.nodes); err != nil {
+		return "", err
+	}
+	return s.out.String(), nil
+}
+
+// Render parses and renders a template.
+func Render(src string, vars map[string]interface{}) (string, error) {
+	t, err := Parse(src)
+	if err != nil {
+		return "", err
+	}
+	return t.Render(vars)
+}
+
+func (s *state) step() error {
+	s.steps++
+	if s.steps > maxSteps || s.out.Len() > maxLength {
+		return errTooComplex
+	}
+	return nil
+}
+
+func (s *state) lookup(name string) interface{} {
+	for i := len(s.scopes) - 1; i >= 0; i-- {
+		if v, ok := s.scopes[i][name]; ok {
+			return v
+		}
+	}
+	if fn, ok := globals[name]; ok {
+		return fn
+	}
+	return Undefined{Name: name}
+}
+
+func (s *state) execNodes(nodes []node) error {
+	for _, n := range nodes {
+		if err := s.step(); err != nil {
+			return err
+		}
+		if err := s.exec(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *state) exec(n node) error {
+	switch n := n.(type) {
+	case *textNode:
+		s.out.WriteString(n.text)
+	case *outputNode:
+		v, err := s.eval(n.expr)
+		if err != nil {
+			return err
+		}
+		s.out.WriteString(toString(v))
+	case *ifNode:
+		for i, cond := range n.conds {
+			v, err := s.eval(cond)
+			if err != nil {
+				return err
+			}
+			if truthy(v) {
+				return s.execNodes(n.bodies[i])
+			}
+		}
+		return s.execNodes(n.orElse)
+	case *forNode:
+		return s.execFor(n)
+	case *setNode:
+		v, err := s.eval(n.expr)
+		if err != nil {
+			return err
+		}
+		s.scopes[len(s.scopes)-1][n.name] = v
+	}
+	return nil
+}
+
+func (s *state) execFor(n *forNode) error {
+	v, err := s.eval(n.iter)
+	if err != nil {
+		return err
+	}
+	all, err := iterate(v)
+	if err != nil {
+		return err
+	}
+
+	var items []map[string]interface{}
+	for _, item := range all {
+		scope := make(map[string]interface{}, len(n.targets)+1)
+		if len(n.targets) == 1 {
+			scope[n.targets[0]] = item
+		} else {
+			values, ok := item.([]interface{})
+			if !ok || len(values) != len(n.targets) {
+				return fmt.Errorf("cannot unpack %s into %d values", repr(item), len(n.targets))
+			}
+			for i, target := range n.targets {
+				scope[target] = values[i]
+			}
+		}
+		if n.filter != nil {
+			s.scopes = append(s.scopes, scope)
+			keep, err := s.eval(n.filter)
+			s.scopes = s.scopes[:len(s.scopes)-1]
+			if err != nil {
+				return err
+			}
+			if !truthy(keep) {
+				continue
+			}
+		}
+		items = append(items, scope)
+	}
+
+	if len(items) == 0 {
+		return s.execNodes(n.orElse)
+	}
+	for i, scope := range items {
+		if err := s.step(); err != nil {
+			return err
+		}
+		scope["loop"] = map[string]interface{}{
+			"index":     i + 1,
+			"index0":    i,
+			"revindex":  len(items) - i,
+			"revindex0": len(items) - i - 1,
+			"first":     i == 0,
+			"last":      i == len(items)-1,
+			"length":    len(items),
+		}
+		s.scopes = append(s.scopes, scope)
+		err := s.execNodes(n.body)
+		s.scopes = s.scopes[:len(s.scopes)-1]
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *state) eval(e expr) (interface{}, error) {
+	switch e := e.(type) {
+	case *literal:
+		return e.value, nil
+	case *nameExpr:
+		return s.lookup(e.name), nil
+	case *listExpr:
+		return s.evalList(e.items)
+	case *dictExpr:
+		d := make(map[string]interface{}, len(e.keys))
+		for i := range e.keys {
+			k, err := s.eval(e.keys[i])
+			if err != nil {
+				return nil, err
+			}
+			v, err := s.eval(e.values[i])
+			if err != nil {
+				return nil, err
+			}
+			d[toString(k)] = v
+		}
+		return d, nil
+	case *attrExpr:
+		obj, err := s.eval(e.obj)
+		if err != nil {
+			return nil, err
+		}
+		return getAttr(obj, e.name)
+	case *itemExpr:
+		obj, err := s.eval(e.obj)
+		if err != nil {
+			return nil, err
+		}
+		key, err := s.eval(e.key)
+		if err != nil {
+			return nil, err
+		}
+		return getItem(obj, key)
+	case *sliceExpr:
+		return s.evalSlice(e)
+	case *callExpr:
+		return s.evalCall(e)
+	case *filterExpr:
+		value, err := s.eval(e.value)
+		if err != nil {
+			return nil, err
+		}
+		f, ok := filters[e.name]
+		if !ok {
+			return nil, fmt.Errorf("no filter named '%s'", e.name)
+		}
+		args, kwargs, err := s.evalArgs(e.args)
+		if err != nil {
+			return nil, err
+		}
+		return f(value, args, kwargs)
+	case *testExpr:
+		value, err := s.eval(e.value)
+		if err != nil {
+			return nil, err
+		}
+		t, ok := tests[e.name]
+		if !ok {
+			return nil, fmt.Errorf("no test named '%s'", e.name)
+		}
+		args, _, err := s.evalArgs(e.args)
+		if err != nil {
+			return nil, err
+		}
+		ok, err = t(value, args)
+		return ok != e.negate, err
+	case *unaryExpr:
+		value, err := s.eval(e.value)
+		if err != nil {
+			return nil, err
+		}
+		return unary(e.op, value)
+	case *binaryExpr:
+		left, err := s.eval(e.left)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case e.op == "and" && !truthy(left), e.op == "or" && truthy(left):
+			return left, nil
+		}
+		right, err := s.eval(e.right)
+		if err != nil {
+			return nil, err
+		}
+		if e.op == "and" || e.op == "or" {
+			return right, nil
+		}
+		return binary(e.op, left, right)
+	case *compareExpr:
+		left, err := s.eval(e.first)
+		if err != nil {
+			return nil, err
+		}
+		for i, op := range e.ops {
+			right, err := s.eval(e.operands[i])
+			if err != nil {
+				return nil, err
+			}
+			ok, err := compare(op, left, right)
+			if err != nil || !ok {
+				return false, err
+			}
+			left = right
+		}
+		return true, nil
+	case *condExpr:
+		cond, err := s.eval(e.cond)
+		if err != nil {
+			return nil, err
+		}
+		if truthy(cond) {
+			return s.eval(e.then)
+		}
+		if e.orElse == nil {
+			return Undefined{}, nil
+		}
+		return s.eval(e.orElse)
+	}
+	return nil, fmt.Errorf("unknown expression %T", e)
+}
+
+func (s *state) evalList(exprs []expr) ([]interface{}, error) {
+	values := make([]interface{}, len(exprs))
+	for i, e := range exprs {
+		v, err := s.eval(e)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func (s *state) evalArgs(args callArgs) ([]interface{}, map[string]interface{}, error) {
+	values, err := s.evalList(args.args)
+	if err != nil {
+		return nil, nil, err
+	}
+	var kwargs map[string]interface{}
+	for name, e := range args.kwargs {
+		v, err := s.eval(e)
+		if err != nil {
+			return nil, nil, err
+		}
+		if kwargs == nil {
+			kwargs = make(map[string]interface{}, len(args.kwargs))
+		}
+		kwargs[name] = v
+	}
+	return values, kwargs, nil
+}
+
+func (s *state) evalCall(e *callExpr) (interface{}, error) {
+	fn, err := s.eval(e.fn)
+	if err != nil {
+		return nil, err
+	}
+	args, kwargs, err := s.evalArgs(e.args)
+	if err != nil {
+		return nil, err
+	}
+	switch fn := fn.(type) {
+	case method:
+		return fn(args, kwargs)
+	case function:
+		return fn(args, kwargs)
+	case Undefined:
+		return nil, undefinedError(fn)
+	}
+	return nil, fmt.Errorf("'%s' object is not callable", typeName(fn))
+}
+
+func (s *state) evalSlice(e *sliceExpr) (interface{}, error) {
+	obj, err := s.eval(e.obj)
+	if err != nil {
+		return nil, err
+	}
+	var bounds [3]*int
+	for i, b := range []expr{e.start, e.stop, e.step} {
+		if b == nil {
+			continue
+		}
+		v, err := s.eval(b)
+		if err != nil {
+			return nil, err
+		}
+		n, ok := v.(int)
+		if !ok && v != nil {
+			return nil, fmt.Errorf("slice indices must be integers")
+		}
+		if ok {
+			bounds[i] = &n
+		}
+	}
+
+	var items []interface{}
+	str, isString := obj.(string)
+	runes := []rune(str)
+	switch obj := obj.(type) {
+	case []interface{}:
+		items = obj
+	case string:
+		items = make([]interface{}, len(runes))
+		for i, r := range runes {
+			items[i] = string(r)
+		}
+	default:
+		return nil, fmt.Errorf("'%s' object is not subscriptable", typeName(obj))
+	}
+
+	step := 1
+	if bounds[2] != nil {
+		step = *bounds[2]
+	}
+	if step == 0 {
+		return nil, fmt.Errorf("slice step cannot be zero")
+	}
+	start, stop := sliceBounds(len(items), bounds[0], bounds[1], step)
+	var out []interface{}
+	for i := start; step > 0 && i < stop || step < 0 && i > stop; i += step {
+		out = append(out, items[i])
+	}
+	if isString {
+		var sb strings.Builder
+		for _, c := range out {
+			sb.WriteString(c.(string))
+		}
+		return sb.String(), nil
+	}
+	if out == nil {
+		out = []interface{}{}
+	}
+	return out, nil
+}
+
+// sliceBounds resolves slice bounds like Python.
+func sliceBounds(n int, start, stop *int, step int) (int, int) {
+	resolve := func(b *int, def, lo, hi int) int {
+		if b == nil {
+			return def
+		}
+		i := *b
+		if i < 0 {
+			i += n
+		}
+		return max(lo, min(i, hi))
+	}
+	if step > 0 {
+		return resolve(start, 0, 0, n), resolve(stop, n, 0, n)
+	}
+	return resolve(start, n-1, -1, n-1), resolve(stop, -1, -1, n-1)
+}
+
+func undefinedError(u Undefined) error {
+	if u.Name == "" {
+		return errors.New("value is undefined")
+	}
+	return fmt.Errorf("'%s' is undefined", u.Name)
+}
+
+// getAttr looks up an attribute, then an item, like Jinja's getattr.
+func getAttr(obj interface{}, name string) (interface{}, error) {
+	if u, ok := obj.(Undefined); ok {
+		return nil, undefinedError(u)
+	}
+	if m := methodOf(obj, name); m != nil {
+		return m, nil
+	}
+	if d, ok := obj.(map[string]interface{}); ok {
+		if v, ok := d[name]; ok {
+			return v, nil
+		}
+	}
+	return Undefined{Name: name}, nil
+}
+
+// getItem looks up an item, then an attribute, like Jinja's getitem.
+func getItem(obj, key interface{}) (interface{}, error) {
+	if u, ok := obj.(Undefined); ok {
+		return nil, undefinedError(u)
+	}
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		if k, ok := key.(string); ok {
+			if v, ok := o[k]; ok {
+				return v, nil
+			}
+		}
+	case []interface{}:
+		if i, ok := key.(int); ok {
+			if i < 0 {
+				i += len(o)
+			}
+			if i >= 0 && i < len(o) {
+				return o[i], nil
+			}
+		}
+	case string:
+		if i, ok := key.(int); ok {
+			runes := []rune(o)
+			if i < 0 {
+				i += len(runes)
+			}
+			if i >= 0 && i < len(runes) {
+				return string(runes[i]), nil
+			}
+		}
+	}
+	if name, ok := key.(string); ok {
+		if m := methodOf(obj, name); m != nil {
+			return m, nil
+		}
+	}
+	return Undefined{Name: toString(key)}, nil
+}
+
+func unary(op string, v interface{}) (interface{}, error) {
+	switch op {
+	case "not":
+		return !truthy(v), nil
+	case "-":
+		switch v := v.(type) {
+		case int:
+			return -v, nil
+		case float64:
+			return -v, nil
+		}
+	case "+":
+		if isNumber(v) {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("bad operand type for unary %s: '%s'", op, typeName(v))
+}
+
+func binary(op string, a, b interface{}) (interface{}, error) {
+	if op == "~" {
+		s := toString(a) + toString(b)
+		if len(s) > maxLength {
+			return nil, errTooComplex
+		}
+		return s, nil
+	}
+
+	ai, aInt := toInt(a)
+	bi, bInt := toInt(b)
+	x, aNum := toNumber(a)
+	y, bNum := toNumber(b)
+	bothInt := aInt && bInt
+
+	switch op {
+	case "+":
+		switch {
+		case bothInt:
+			return ai + bi, nil
+		case aNum && bNum:
+			return x + y, nil
+		}
+		if as, ok := a.(string); ok {
+			if bs, ok := b.(string); ok {
+				if len(as)+len(bs) > maxLength {
+					return nil, errTooComplex
+				}
+				return as + bs, nil
+			}
+		}
+		if al, ok := a.([]interface{}); ok {
+			if bl, ok := b.([]interface{}); ok {
+				if len(al)+len(bl) > maxLength {
+					return nil, errTooComplex
+				}
+				return append(append([]interface{}{}, al...), bl...), nil
+			}
+		}
+	case "-":
+		switch {
+		case bothInt:
+			return ai - bi, nil
+		case aNum && bNum:
+			return x - y, nil
+		}
+	case "*":
+		switch {
+		case bothInt:
+			return ai * bi, nil
+		case aNum && bNum:
+			return x * y, nil
+		}
+		if s, ok := a.(string); ok && bInt {
+			if bi > 0 && len(s)*bi > maxLength {
+				return nil, errTooComplex
+			}
+			return strings.Repeat(s, max(bi, 0)), nil
+		}
+	case "/":
+		if aNum && bNum {
+			if y == 0 {
+				return nil, errors.New("division by zero")
+			}
+			return x / y, nil
+		}
+	case "//":
+		if aNum && bNum {
+			if y == 0 {
+				return nil, errors.New("integer division or modulo by zero")
+			}
+			if bothInt {
+				return int(math.Floor(float64(ai) / float64(bi))), nil
+			}
+			return math.Floor(x / y), nil
+		}
+	case "%":
+		if _, ok := a.(string); ok {
+			return nil, errors.New("string formatting with % is not supported")
+		}
+		if aNum && bNum {
+			if y == 0 {
+				return nil, errors.New("integer division or modulo by zero")
+			}
+			if bothInt {
+				return ((ai % bi) + bi) % bi, nil
+			}
+			m := math.Mod(x, y)
+			if m != 0 && (m < 0) != (y < 0) {
+				m += y
+			}
+			return m, nil
+		}
+	case "**":
+		if bothInt && bi >= 0 && bi < 64 {
+			r := 1
+			for i := 0; i < bi; i++ {
+				r *= ai
+			}
+			return r, nil
+		}
+		if aNum && bNum {
+			return math.Pow(x, y), nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported operand type(s) for %s: '%s' and '%s'", op, typeName(a), typeName(b))
+}
+
+// toInt returns the value of ints and booleans.
+func toInt(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func compare(op string, a, b interface{}) (bool, error) {
+	switch op {
+	case "==":
+		return equal(a, b), nil
+	case "!=":
+		return !equal(a, b), nil
+	case "in", "not in":
+		in, err := contains(b, a)
+		return in == (op == "in"), err
+	}
+
+	var c int
+	if x, ok := toNumber(a); ok {
+		y, ok := toNumber(b)
+		if !ok {
+			return false, fmt.Errorf("'%s' not supported between instances of '%s' and '%s'", op, typeName(a), typeName(b))
+		}
+		c = cmpFloat(x, y)
+	} else if as, ok := a.(string); ok {
+		bs, ok := b.(string)
+		if !ok {
+			return false, fmt.Errorf("'%s' not supported between instances of '%s' and '%s'", op, typeName(a), typeName(b))
+		}
+		c = strings.Compare(as, bs)
+	} else {
+		return false, fmt.Errorf("'%s' not supported between instances of '%s' and '%s'", op, typeName(a), typeName(b))
+	}
+
+	switch op {
+	case "<":
+		return c < 0, nil
+	case ">":
+		return c > 0, nil
+	case "<=":
+		return c <= 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+func cmpFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// contains reports whether a container holds a value, like Python's in.
+func contains(container, v interface{}) (bool, error) {
+	switch c := container.(type) {
+	case string:
+		s, ok := v.(string)
+		if !ok {
+			return false, fmt.Errorf("'in <string>' requires string as left operand, not %s", typeName(v))
+		}
+		return strings.Contains(c, s), nil
+	case []interface{}:
+		for _, item := range c {
+			if equal(item, v) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]interface{}:
+		s, ok := v.(string)
+		if !ok {
+			return false, nil
+		}
+		_, ok = c[s]
+		return ok, nil
+	case Undefined:
+		return false, undefinedError(c)
+	}
+	return false, fmt.Errorf("argument of type '%s' is not iterable", typeName(container))
+}
@@ -0,0 +1,710 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jinja
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type filterFunc func(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error)
+
+type testFunc func(v interface{}, args []interface{}) (bool, error)
+
+// filters are the Jinja and Home Assistant filters commonly used in MQTT
+// templates. Others render as an error.
+var filters map[string]filterFunc
+
+// globals are the Home Assistant functions available to templates.
+var globals map[string]function
+
+func init() {
+	filters = map[string]filterFunc{
+		"abs":        filterAbs,
+		"bool":       filterBool,
+		"capitalize": stringFilter(capitalize),
+		"count":      filterLength,
+		"d":          filterDefault,
+		"default":    filterDefault,
+		"first":      filterFirst,
+		"float":      filterFloat,
+		"from_json":  filterFromJSON,
+		"iif":        filterIif,
+		"int":        filterInt,
+		"is_defined": filterIsDefined,
+		"is_number":  filterIsNumber,
+		"join":       filterJoin,
+		"last":       filterLast,
+		"length":     filterLength,
+		"list":       filterList,
+		"lower":      stringFilter(strings.ToLower),
+		"max":        filterMinMax(1),
+		"min":        filterMinMax(-1),
+		"multiply":   filterMultiply,
+		"replace":    filterReplace,
+		"round":      filterRound,
+		"sort":       filterSort,
+		"string": func(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+			return toString(v), nil
+		},
+		"sum":     filterSum,
+		"title":   stringFilter(title),
+		"to_json": filterToJSON,
+		"tojson":  filterToJSON,
+		"trim":    filterTrim,
+		"upper":   stringFilter(strings.ToUpper),
+	}
+
+	globals = map[string]function{"range": rangeFunc}
+	// These filters are also functions taking the value first
+	for _, name := range []string{"bool", "float", "iif", "int", "is_number", "max", "min"} {
+		f := filters[name]
+		globals[name] = func(args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+			if len(args) == 0 {
+				return nil, fmt.Errorf("%s() missing required argument", name)
+			}
+			return f(args[0], args[1:], kwargs)
+		}
+	}
+}
+
+// param returns the i-th positional argument, or the keyword argument of the
+// same name.
+func param(args []interface{}, kwargs map[string]interface{}, i int, name string) (interface{}, bool) {
+	if i < len(args) {
+		return args[i], true
+	}
+	v, ok := kwargs[name]
+	return v, ok
+}
+
+// noDefault is the error of a conversion filter without a default, as Home
+// Assistant reports it.
+func noDefault(name string, v interface{}) error {
+	return fmt.Errorf("%s got invalid input %s but no default was specified", name, repr(v))
+}
+
+func stringFilter(f func(string) string) filterFunc {
+	return func(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+		return f(toString(v)), nil
+	}
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(strings.ToLower(s))
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func title(s string) string {
+	r := []rune(strings.ToLower(s))
+	for i := range r {
+		if i == 0 || !unicode.IsLetter(r[i-1]) {
+			r[i] = unicode.ToUpper(r[i])
+		}
+	}
+	return string(r)
+}
+
+// parseFloat converts a value like Python's float.
+func parseFloat(v interface{}) (float64, bool) {
+	if f, ok := toNumber(v); ok {
+		return f, true
+	}
+	s, ok := v.(string)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(s), "_", ""), 64)
+	return f, err == nil
+}
+
+func filterFloat(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	if f, ok := parseFloat(v); ok {
+		return f, nil
+	}
+	if def, ok := param(args, kwargs, 0, "default"); ok {
+		return def, nil
+	}
+	return nil, noDefault("float", v)
+}
+
+func filterInt(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	if i, ok := toInt(v); ok {
+		return i, nil
+	}
+	if s, ok := v.(string); ok {
+		base := 10
+		if b, ok := param(args, kwargs, 1, "base"); ok {
+			if base, ok = b.(int); !ok {
+				return nil, errors.New("int base must be an integer")
+			}
+		}
+		if i, err := strconv.ParseInt(strings.TrimSpace(s), base, 64); err == nil {
+			return int(i), nil
+		}
+	}
+	if f, ok := parseFloat(v); ok && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return int(f), nil
+	}
+	if def, ok := param(args, kwargs, 0, "default"); ok {
+		return def, nil
+	}
+	return nil, noDefault("int", v)
+}
+
+func filterRound(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	precision := 0
+	if p, ok := param(args, kwargs, 0, "precision"); ok {
+		if precision, ok = p.(int); !ok {
+			return nil, errors.New("round precision must be an integer")
+		}
+	}
+	method := "common"
+	if m, ok := param(args, kwargs, 1, "method"); ok {
+		method = toString(m)
+	}
+
+	f, ok := parseFloat(v)
+	if !ok {
+		if def, ok := param(args, kwargs, 2, "default"); ok {
+			return def, nil
+		}
+		return nil, noDefault("round", v)
+	}
+	multiplier := math.Pow(10, float64(precision))
+	switch method {
+	case "ceil":
+		f = math.Ceil(f*multiplier) / multiplier
+	case "floor":
+		f = math.Floor(f*multiplier) / multiplier
+	case "half":
+		f = math.RoundToEven(f*2) / 2
+	default:
+		f = math.RoundToEven(f*multiplier) / multiplier
+	}
+	if precision == 0 {
+		return int(f), nil
+	}
+	return f, nil
+}
+
+func filterMultiply(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	amount, ok := param(args, kwargs, 0, "amount")
+	if !ok {
+		return nil, errors.New("multiply() missing required argument amount")
+	}
+	f, ok := parseFloat(v)
+	if !ok {
+		if def, ok := param(args, kwargs, 1, "default"); ok {
+			return def, nil
+		}
+		return nil, noDefault("multiply", v)
+	}
+	return binary("*", f, amount)
+}
+
+func filterIif(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	if v == nil {
+		if ifNone, ok := param(args, kwargs, 2, "if_none"); ok {
+			return ifNone, nil
+		}
+	}
+	if truthy(v) {
+		if ifTrue, ok := param(args, kwargs, 0, "if_true"); ok {
+			return ifTrue, nil
+		}
+		return true, nil
+	}
+	if ifFalse, ok := param(args, kwargs, 1, "if_false"); ok {
+		return ifFalse, nil
+	}
+	return false, nil
+}
+
+func filterBool(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	switch b := v.(type) {
+	case bool:
+		return b, nil
+	case int, float64:
+		return truthy(b), nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(b)) {
+		case "1", "true", "yes", "on", "enable":
+			return true, nil
+		case "0", "false", "no", "off", "disable":
+			return false, nil
+		}
+	}
+	if def, ok := param(args, kwargs, 0, "default"); ok {
+		return def, nil
+	}
+	return nil, noDefault("bool", v)
+}
+
+func filterDefault(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	_, undefined := v.(Undefined)
+	if b, _ := param(args, kwargs, 1, "boolean"); truthy(b) && !truthy(v) {
+		undefined = true
+	}
+	if !undefined {
+		return v, nil
+	}
+	if def, ok := param(args, kwargs, 0, "default_value"); ok {
+		return def, nil
+	}
+	return "", nil
+}
+
+func filterIsDefined(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	if u, ok := v.(Undefined); ok {
+		return nil, undefinedError(u)
+	}
+	return v, nil
+}
+
+func filterIsNumber(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	f, ok := parseFloat(v)
+	if _, isBool := v.(bool); isBool {
+		ok = false
+	}
+	return ok && !math.IsNaN(f) && !math.IsInf(f, 0), nil
+}
+
+func filterAbs(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	switch n := v.(type) {
+	case int:
+		if n < 0 {
+			return -n, nil
+		}
+		return n, nil
+	case float64:
+		return math.Abs(n), nil
+	}
+	return nil, fmt.Errorf("bad operand type for abs(): '%s'", typeName(v))
+}
+
+func filterLength(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	switch c := v.(type) {
+	case string:
+		return len([]rune(c)), nil
+	case []interface{}:
+		return len(c), nil
+	case map[string]interface{}:
+		return len(c), nil
+	case Undefined:
+		return 0, nil
+	}
+	return nil, fmt.Errorf("object of type '%s' has no len()", typeName(v))
+}
+
+func filterFirst(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	items, err := iterate(v)
+	if err != nil || len(items) == 0 {
+		return Undefined{}, err
+	}
+	return items[0], nil
+}
+
+func filterLast(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	items, err := iterate(v)
+	if err != nil || len(items) == 0 {
+		return Undefined{}, err
+	}
+	return items[len(items)-1], nil
+}
+
+func filterList(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	items, err := iterate(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]interface{}{}, items...), nil
+}
+
+func filterJoin(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	items, err := iterate(v)
+	if err != nil {
+		return nil, err
+	}
+	sep := ""
+	if d, ok := param(args, kwargs, 0, "d"); ok {
+		sep = toString(d)
+	}
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = toString(item)
+	}
+	return strings.Join(parts, sep), nil
+}
+
+func filterReplace(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	old, ok1 := param(args, kwargs, 0, "old")
+	replacement, ok2 := param(args, kwargs, 1, "new")
+	if !ok1 || !ok2 {
+		return nil, errors.New("replace() missing required arguments old and new")
+	}
+	count := -1
+	if c, ok := param(args, kwargs, 2, "count"); ok {
+		if count, ok = c.(int); !ok {
+			return nil, errors.New("replace count must be an integer")
+		}
+	}
+	return strings.Replace(toString(v), toString(old), toString(replacement), count), nil
+}
+
+func filterTrim(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	if chars, ok := param(args, kwargs, 0, "chars"); ok && chars != nil {
+		return strings.Trim(toString(v), toString(chars)), nil
+	}
+	return strings.TrimSpace(toString(v)), nil
+}
+
+func filterMinMax(sign int) filterFunc {
+	return func(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+		items, err := iterate(v)
+		if err != nil {
+			return nil, err
+		}
+		var best interface{} = Undefined{}
+		for i, item := range items {
+			if i == 0 {
+				best = item
+				continue
+			}
+			less, err := compare("<", item, best)
+			if err != nil {
+				return nil, err
+			}
+			if less == (sign < 0) && !equal(item, best) {
+				best = item
+			}
+		}
+		return best, nil
+	}
+}
+
+func filterSort(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	items, err := iterate(v)
+	if err != nil {
+		return nil, err
+	}
+	sorted := append([]interface{}{}, items...)
+	var sortErr error
+	sort.SliceStable(sorted, func(i, j int) bool {
+		less, err := compare("<", sorted[i], sorted[j])
+		if err != nil {
+			sortErr = err
+		}
+		return less
+	})
+	if reverse, _ := param(args, kwargs, 0, "reverse"); truthy(reverse) {
+		for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		}
+	}
+	return sorted, sortErr
+}
+
+func filterSum(v interface{}, args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+	items, err := iterate(v)
+	if err != nil {
+		return nil, err
+	}
+	total, _ := param(args, kwargs, 1, "start")
+	if total == nil {
+		total = 0
+	}
+	for _, item := range items {
+		if total, err = binary("+", total, item); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+func filterToJSON(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	data, err := json.Marshal(toJSON(v))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func toJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case Undefined:
+		return nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i := range v {
+			out[i] = toJSON(v[i])
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k := range v {
+			out[k] = toJSON(v[k])
+		}
+		return out
+	case method, function:
+		return nil
+	}
+	return v
+}
+
+func filterFromJSON(v interface{}, _ []interface{}, _ map[string]interface{}) (interface{}, error) {
+	return FromJSON(toString(v))
+}
+
+func rangeFunc(args []interface{}, _ map[string]interface{}) (interface{}, error) {
+	var bounds []int
+	for _, a := range args {
+		i, ok := a.(int)
+		if !ok {
+			return nil, fmt.Errorf("'%s' object cannot be interpreted as an integer", typeName(a))
+		}
+		bounds = append(bounds, i)
+	}
+	start, stop, step := 0, 0, 1
+	switch len(bounds) {
+	case 1:
+		stop = bounds[0]
+	case 2:
+		start, stop = bounds[0], bounds[1]
+	case 3:
+		start, stop, step = bounds[0], bounds[1], bounds[2]
+	default:
+		return nil, fmt.Errorf("range expected 1 to 3 arguments, got %d", len(bounds))
+	}
+	if step == 0 {
+		return nil, errors.New("range() arg 3 must not be zero")
+	}
+	var out []interface{}
+	for i := start; step > 0 && i < stop || step < 0 && i > stop; i += step {
+		if len(out) >= maxSteps {
+			return nil, errTooComplex
+		}
+		out = append(out, i)
+	}
+	if out == nil {
+		out = []interface{}{}
+	}
+	return out, nil
+}
+
+// tests are the Jinja tests commonly used in templates.
+var tests = map[string]testFunc{
+	"defined": func(v interface{}, _ []interface{}) (bool, error) {
+		_, undefined := v.(Undefined)
+		return !undefined, nil
+	},
+	"undefined": func(v interface{}, _ []interface{}) (bool, error) {
+		_, undefined := v.(Undefined)
+		return undefined, nil
+	},
+	"none":    func(v interface{}, _ []interface{}) (bool, error) { return v == nil, nil },
+	"number":  func(v interface{}, _ []interface{}) (bool, error) { return isNumber(v), nil },
+	"integer": func(v interface{}, _ []interface{}) (bool, error) { _, ok := v.(int); return ok, nil },
+	"float":   func(v interface{}, _ []interface{}) (bool, error) { _, ok := v.(float64); return ok, nil },
+	"string":  func(v interface{}, _ []interface{}) (bool, error) { _, ok := v.(string); return ok, nil },
+	"boolean": func(v interface{}, _ []interface{}) (bool, error) { _, ok := v.(bool); return ok, nil },
+	"true":    func(v interface{}, _ []interface{}) (bool, error) { return v == true, nil },
+	"false":   func(v interface{}, _ []interface{}) (bool, error) { return v == false, nil },
+	"mapping": func(v interface{}, _ []interface{}) (bool, error) {
+		_, ok := v.(map[string]interface{})
+		return ok, nil
+	},
+	"sequence": isSequence,
+	"iterable": isSequence,
+	"eq":       compareTest("=="),
+	"equalto":  compareTest("=="),
+	"ne":       compareTest("!="),
+	"lt":       compareTest("<"),
+	"le":       compareTest("<="),
+	"gt":       compareTest(">"),
+	"ge":       compareTest(">="),
+	"in":       compareTest("in"),
+	"even": func(v interface{}, _ []interface{}) (bool, error) {
+		i, ok := v.(int)
+		return ok && i%2 == 0, nil
+	},
+	"odd": func(v interface{}, _ []interface{}) (bool, error) {
+		i, ok := v.(int)
+		return ok && i%2 != 0, nil
+	},
+	"divisibleby": func(v interface{}, args []interface{}) (bool, error) {
+		if len(args) != 1 {
+			return false, errors.New("divisibleby expects one argument")
+		}
+		m, err := binary("%", v, args[0])
+		return err == nil && equal(m, 0), err
+	},
+}
+
+func isSequence(v interface{}, _ []interface{}) (bool, error) {
+	switch v.(type) {
+	case string, []interface{}, map[string]interface{}:
+		return true, nil
+	}
+	return false, nil
+}
+
+func compareTest(op string) testFunc {
+	return func(v interface{}, args []interface{}) (bool, error) {
+		if len(args) != 1 {
+			return false, fmt.Errorf("test expects one argument")
+		}
+		return compare(op, v, args[0])
+	}
+}
+
+// methodOf returns the Python method of a string or dict, or nil.
+func methodOf(obj interface{}, name string) interface{} {
+	switch o := obj.(type) {
+	case string:
+		return stringMethod(o, name)
+	case map[string]interface{}:
+		return dictMethod(o, name)
+	}
+	return nil
+}
+
+func stringMethod(s, name string) interface{} {
+	switch name {
+	case "lower", "upper", "title", "capitalize", "strip", "lstrip", "rstrip", "isdigit":
+		return method(func(args []interface{}, _ map[string]interface{}) (interface{}, error) {
+			cutset := " \t\r\n"
+			if len(args) > 0 && args[0] != nil {
+				cutset = toString(args[0])
+			}
+			switch name {
+			case "lower":
+				return strings.ToLower(s), nil
+			case "upper":
+				return strings.ToUpper(s), nil
+			case "title":
+				return title(s), nil
+			case "capitalize":
+				return capitalize(s), nil
+			case "strip":
+				return strings.Trim(s, cutset), nil
+			case "lstrip":
+				return strings.TrimLeft(s, cutset), nil
+			case "rstrip":
+				return strings.TrimRight(s, cutset), nil
+			}
+			return s != "" && strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) }) < 0, nil
+		})
+	case "startswith", "endswith":
+		return method(func(args []interface{}, _ map[string]interface{}) (interface{}, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("%s() takes one argument", name)
+			}
+			if name == "startswith" {
+				return strings.HasPrefix(s, toString(args[0])), nil
+			}
+			return strings.HasSuffix(s, toString(args[0])), nil
+		})
+	case "replace":
+		return method(func(args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+			return filterReplace(s, args, kwargs)
+		})
+	case "split":
+		return method(func(args []interface{}, kwargs map[string]interface{}) (interface{}, error) {
+			n := -1
+			if m, ok := param(args, kwargs, 1, "maxsplit"); ok {
+				if i, ok := m.(int); ok && i >= 0 {
+					n = i + 1
+				}
+			}
+			var parts []string
+			if sep, ok := param(args, kwargs, 0, "sep"); ok && sep != nil {
+				if toString(sep) == "" {
+					return nil, errors.New("empty separator")
+				}
+				parts = strings.SplitN(s, toString(sep), n)
+			} else {
+				parts = strings.Fields(s)
+				if n > 0 && len(parts) > n {
+					rest := strings.TrimLeft(s, " \t\r\n")
+					for i := 0; i < n-1; i++ {
+						rest = strings.TrimLeft(strings.TrimPrefix(rest, parts[i]), " \t\r\n")
+					}
+					parts = append(parts[:n-1], strings.TrimRight(rest, " \t\r\n"))
+				}
+			}
+			out := make([]interface{}, len(parts))
+			for i, p := range parts {
+				out[i] = p
+			}
+			return out, nil
+		})
+	case "join":
+		return method(func(args []interface{}, _ map[string]interface{}) (interface{}, error) {
+			if len(args) != 1 {
+				return nil, errors.New("join() takes one argument")
+			}
+			return filterJoin(args[0], []interface{}{s}, nil)
+		})
+	}
+	return nil
+}
+
+func dictMethod(d map[string]interface{}, name string) interface{} {
+	switch name {
+	case "get":
+		return method(func(args []interface{}, _ map[string]interface{}) (interface{}, error) {
+			if len(args) == 0 || len(args) > 2 {
+				return nil, errors.New("get expected 1 or 2 arguments")
+			}
+			if v, ok := d[toString(args[0])]; ok {
+				return v, nil
+			}
+			if len(args) == 2 {
+				return args[1], nil
+			}
+			return nil, nil
+		})
+	case "keys", "values", "items":
+		return method(func(_ []interface{}, _ map[string]interface{}) (interface{}, error) {
+			keys := sortedKeys(d)
+			out := make([]interface{}, len(keys))
+			for i, k := range keys {
+				switch name {
+				case "keys":
+					out[i] = k
+				case "values":
+					out[i] = d[k]
+				default:
+					out[i] = []interface{}{k, d[k]}
+				}
+			}
+			return out, nil
+		})
+	}
+	return nil
+}
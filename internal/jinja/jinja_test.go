@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jinja
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	payload := `{"temperature": "21.56", "humidity": 48, "state": "ON", "readings": [1, 2, 3], "nested": {"level": 0.5}}`
+
+	tests := []struct {
+		name     string
+		template string
+		payload  string
+		want     string
+		wantErr  string
+	}{
+		{name: "text", template: "ON", want: "ON"},
+		{name: "value", template: "{{ value }}", payload: "21.5", want: "21.5"},
+		{name: "value_json", template: "{{ value_json.humidity }}", payload: payload, want: "48"},
+		{name: "item access", template: "{{ value_json['nested']['level'] }}", payload: payload, want: "0.5"},
+		{name: "negative index", template: "{{ value_json.readings[-1] }}", payload: payload, want: "3"},
+		{name: "float and round", template: "{{ value_json.temperature | float | round(1) }}", payload: payload, want: "21.6"},
+		{name: "round to integer", template: "{{ value_json.temperature | round }}", payload: payload, want: "22"},
+		{name: "round floor", template: "{{ 21.56 | round(1, 'floor') }}", want: "21.5"},
+		{name: "int of float string", template: "{{ value | int }}", payload: "21.9", want: "21"},
+		{name: "int default", template: "{{ value | int(0) }}", payload: "unavailable", want: "0"},
+		{name: "float default", template: "{{ value | float(-1) }}", payload: "unavailable", want: "-1"},
+		{name: "float function", template: "{{ float(value) * 2 }}", payload: "1.25", want: "2.5"},
+		{name: "iif filter", template: "{{ (value == 'ON') | iif('on', 'off') }}", payload: "ON", want: "on"},
+		{name: "iif function", template: "{{ iif(value_json.humidity > 50, 'wet', 'dry') }}", payload: payload, want: "dry"},
+		{name: "iif none", template: "{{ iif(none, 'yes', 'no', 'unknown') }}", want: "unknown"},
+		{name: "bool", template: "{{ value | bool }}", payload: "on", want: "True"},
+		{name: "arithmetic", template: "{{ (value | float * 9 / 5 + 32) | round(1) }}", payload: "20", want: "68.0"},
+		{name: "integer arithmetic", template: "{{ 7 // 2 }} {{ -7 % 3 }} {{ 2 ** 8 }} {{ 1 / 4 }}", want: "3 2 256 0.25"},
+		{name: "concat", template: "{{ value ~ ' %' }}", payload: "48", want: "48 %"},
+		{name: "if", template: "{% if value_json.state == 'ON' %}on{% elif value_json.state == 'OFF' %}off{% else %}unknown{% endif %}", payload: payload, want: "on"},
+		{name: "for", template: "{% for r in value_json.readings if r > 1 %}{{ r }}{% if not loop.last %},{% endif %}{% endfor %}", payload: payload, want: "2,3"},
+		{name: "for else", template: "{% for r in [] %}{{ r }}{% else %}none{% endfor %}", want: "none"},
+		{name: "set", template: "{% set t = value | float %}{{ t > 20 }}", payload: "21", want: "True"},
+		{name: "conditional expression", template: "{{ 'hot' if value | float > 30 else 'ok' }}", payload: "21", want: "ok"},
+		{name: "string methods", template: "{{ value.split(',')[1].strip().upper() }}", payload: "a, b, c", want: "B"},
+		{name: "dict get", template: "{{ value_json.get('missing', 'default') }}", payload: payload, want: "default"},
+		{name: "slice", template: "{{ value[:3] }} {{ [1, 2, 3][::-1] }}", payload: "abcdef", want: "abc [3, 2, 1]"},
+		{name: "tests", template: "{{ value_json.missing is defined }} {{ value_json.humidity is number }} {{ 4 is divisibleby 2 }}", payload: payload, want: "False True True"},
+		{name: "default", template: "{{ value_json.missing | default('n/a') }}", payload: payload, want: "n/a"},
+		{name: "undefined renders empty", template: "[{{ value_json.missing }}]", payload: payload, want: "[]"},
+		{name: "tojson", template: "{{ {'state': value, 'level': 0.5} | tojson }}", payload: "on", want: `{"level":0.5,"state":"on"}`},
+		{name: "whitespace control", template: "  {{- value -}}  \n", payload: "x", want: "x"},
+		{name: "comment", template: "a{# note #}b", want: "ab"},
+		{name: "join and length", template: "{{ value_json.readings | join('-') }} {{ value_json.readings | length }}", payload: payload, want: "1-2-3 3"},
+		{name: "float without default", template: "{{ value | float }}", payload: "unavailable", wantErr: "float got invalid input 'unavailable' but no default was specified"},
+		{name: "attribute of undefined", template: "{{ value_json.missing.level }}", payload: payload, wantErr: "'missing' is undefined"},
+		{name: "no value_json for plain payloads", template: "{{ value_json.state }}", payload: "ON", wantErr: "'value_json' is undefined"},
+		{name: "unsupported filter", template: "{{ value | timestamp_custom('%H') }}", payload: "0", wantErr: "no filter named 'timestamp_custom'"},
+		{name: "type error", template: "{{ value + 1 }}", payload: "1", wantErr: "unsupported operand type(s) for +: 'str' and 'int'"},
+		{name: "division by zero", template: "{{ 1 / 0 }}", wantErr: "division by zero"},
+		{name: "too complex", template: "{% for i in range(1000) %}{% for j in range(1000) %}{% endfor %}{% endfor %}", wantErr: "too complex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.template, PayloadVars(tt.payload))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Render() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		template string
+		wantErr  string
+	}{
+		{template: "{{ value_json.temperature | float(0) | round(1) }}"},
+		{template: "{% if value == 'ON' %}on{% else %}off{% endif %}"},
+		{template: "{{ value | timestamp_custom('%H:%M') }}"},
+		{template: "{% macro m() %}{% endmacro %}"},
+		{template: "{{ value_json.temperature", wantErr: "line 1: unexpected end of template, expected '}}'"},
+		{template: "{{ value |  }}", wantErr: "line 1: expected name, got '}}'"},
+		{template: "{{ 'unterminated }}", wantErr: "line 1: unexpected end of string"},
+		{template: "{{ a b }}", wantErr: "line 1: expected '}}', got 'b'"},
+		{template: "{% if value %}\non", wantErr: "line 2: unexpected end of template, expected 'elif' or 'else' or 'endif'"},
+		{template: "{% endif %}", wantErr: "line 1: encountered unknown tag 'endif'"},
+		{template: "line\n{{ value ! }}", wantErr: "line 2: unexpected char '!'"},
+		{template: "{{ f(a=1, 2) }}", wantErr: "line 1: positional argument follows keyword argument"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			err := Check(tt.template)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Check() error: %v", err)
+				}
+				return
+			}
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) || err.Error() != tt.wantErr {
+				t.Errorf("Check() error = %v, want SyntaxError %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := Parse("{% macro m() %}{% endmacro %}"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Parse(macro) error = %v, want ErrUnsupported", err)
+	}
+}
+
+func TestPayloadVars(t *testing.T) {
+	vars := PayloadVars(`{"a": 1, "b": 1.5}`)
+	v, ok := vars["value_json"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("value_json = %#v, want a dict", vars["value_json"])
+	}
+	if _, ok := v["a"].(int); !ok {
+		t.Errorf("value_json.a = %#v, want an int", v["a"])
+	}
+	if _, ok := v["b"].(float64); !ok {
+		t.Errorf("value_json.b = %#v, want a float", v["b"])
+	}
+
+	vars = PayloadVars("ON")
+	if _, ok := vars["value_json"]; ok || vars["value"] != "ON" {
+		t.Errorf("PayloadVars(ON) = %v, want only value", vars)
+	}
+}
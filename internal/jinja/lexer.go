@@ -0,0 +1,284 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jinja
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokText tokenKind = iota
+	tokVarBegin
+	tokVarEnd
+	tokBlockBegin
+	tokBlockEnd
+	tokName
+	tokNumber
+	tokString
+	tokOperator
+	tokEOF
+)
+
+type token struct {
+	kind tokenKind
+	val  string
+	line int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokText:
+		return "template data"
+	case tokVarBegin:
+		return "'{{'"
+	case tokVarEnd:
+		return "'}}'"
+	case tokBlockBegin:
+		return "'{%'"
+	case tokBlockEnd:
+		return "'%}'"
+	case tokString:
+		return "string"
+	case tokEOF:
+		return "end of template"
+	}
+	return fmt.Sprintf("'%s'", t.val)
+}
+
+// operators are matched longest first.
+var operators = []string{
+	"==", "!=", "<=", ">=", "//", "**",
+	"+", "-", "*", "/", "%", "~", "<", ">", "=", "(", ")", "[", "]", "{", "}", ".", ",", ":", "|",
+}
+
+type lexer struct {
+	src    string
+	pos    int
+	line   int
+	tokens []token
+	// lstrip removes the leading whitespace of the next text, after a
+	// tag closed with a minus sign.
+	lstrip bool
+}
+
+// lex splits a template into its tokens.
+func lex(src string) ([]token, error) {
+	l := &lexer{src: src, line: 1}
+	for l.pos < len(l.src) {
+		if err := l.lexText(); err != nil {
+			return nil, err
+		}
+	}
+	l.emit(tokEOF, "")
+	return l.tokens, nil
+}
+
+func (l *lexer) emit(kind tokenKind, val string) {
+	l.tokens = append(l.tokens, token{kind: kind, val: val, line: l.line})
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Line: l.line, Msg: fmt.Sprintf(format, args...)}
+}
+
+// lexText reads the text up to the next tag and then the tag itself.
+func (l *lexer) lexText() error {
+	start := l.pos
+	next := -1
+	for i := start; i+1 < len(l.src); i++ {
+		if l.src[i] == '{' && strings.ContainsRune("{%#", rune(l.src[i+1])) {
+			next = i
+			break
+		}
+	}
+	end := next
+	if end < 0 {
+		end = len(l.src)
+	}
+
+	text := l.src[start:end]
+	if l.lstrip {
+		text = strings.TrimLeft(text, " \t\r\n")
+		l.lstrip = false
+	}
+	if next >= 0 && next+2 < len(l.src) && l.src[next+2] == '-' {
+		text = strings.TrimRight(text, " \t\r\n")
+	}
+	if text != "" {
+		l.emit(tokText, text)
+	}
+	l.line += strings.Count(l.src[start:end], "\n")
+	l.pos = end
+	if next < 0 {
+		return nil
+	}
+
+	l.pos += 2
+	if l.pos < len(l.src) && l.src[l.pos] == '-' {
+		l.pos++
+	}
+	switch l.src[next+1] {
+	case '#':
+		return l.lexComment()
+	case '{':
+		l.emit(tokVarBegin, "{{")
+		return l.lexTag("}}", tokVarEnd)
+	default:
+		l.emit(tokBlockBegin, "{%")
+		return l.lexTag("%}", tokBlockEnd)
+	}
+}
+
+func (l *lexer) lexComment() error {
+	end := strings.Index(l.src[l.pos:], "#}")
+	if end < 0 {
+		return l.errorf("missing end of comment tag")
+	}
+	comment := l.src[l.pos : l.pos+end]
+	l.lstrip = strings.HasSuffix(comment, "-")
+	l.line += strings.Count(comment, "\n")
+	l.pos += end + 2
+	return nil
+}
+
+// lexTag reads the tokens of an expression or statement up to its closing
+// delimiter.
+func (l *lexer) lexTag(closing string, kind tokenKind) error {
+	for {
+		for l.pos < len(l.src) && strings.ContainsRune(" \t\r\n", rune(l.src[l.pos])) {
+			if l.src[l.pos] == '\n' {
+				l.line++
+			}
+			l.pos++
+		}
+		if l.pos >= len(l.src) {
+			return l.errorf("unexpected end of template, expected '%s'", closing)
+		}
+
+		rest := l.src[l.pos:]
+		switch {
+		case strings.HasPrefix(rest, closing):
+			l.pos += len(closing)
+			l.emit(kind, closing)
+			return nil
+		case strings.HasPrefix(rest, "-"+closing):
+			l.pos += len(closing) + 1
+			l.lstrip = true
+			l.emit(kind, closing)
+			return nil
+		case rest[0] == '\'' || rest[0] == '"':
+			if err := l.lexString(); err != nil {
+				return err
+			}
+		case isDigit(rest[0]):
+			l.lexNumber()
+		case isNameStart(rest[0]):
+			end := 1
+			for end < len(rest) && isNameChar(rest[end]) {
+				end++
+			}
+			l.emit(tokName, rest[:end])
+			l.pos += end
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(rest, o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return l.errorf("unexpected char %q", rest[0])
+			}
+			l.emit(tokOperator, op)
+			l.pos += len(op)
+		}
+	}
+}
+
+func (l *lexer) lexString() error {
+	quote := l.src[l.pos]
+	var sb strings.Builder
+	line := l.line
+	for i := l.pos + 1; i < len(l.src); i++ {
+		c := l.src[i]
+		switch {
+		case c == quote:
+			l.tokens = append(l.tokens, token{kind: tokString, val: sb.String(), line: line})
+			l.pos = i + 1
+			return nil
+		case c == '\\' && i+1 < len(l.src):
+			i++
+			switch e := l.src[i]; e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case '\\', '\'', '"':
+				sb.WriteByte(e)
+			default:
+				sb.WriteByte('\\')
+				sb.WriteByte(e)
+			}
+		default:
+			if c == '\n' {
+				l.line++
+			}
+			sb.WriteByte(c)
+		}
+	}
+	l.line = line
+	return l.errorf("unexpected end of string")
+}
+
+func (l *lexer) lexNumber() {
+	rest := l.src[l.pos:]
+	end := 0
+	digits := func() {
+		for end < len(rest) && (isDigit(rest[end]) || rest[end] == '_') {
+			end++
+		}
+	}
+	digits()
+	if end+1 < len(rest) && rest[end] == '.' && isDigit(rest[end+1]) {
+		end++
+		digits()
+	}
+	if end < len(rest) && (rest[end] == 'e' || rest[end] == 'E') {
+		exp := end + 1
+		if exp < len(rest) && (rest[exp] == '+' || rest[exp] == '-') {
+			exp++
+		}
+		if exp < len(rest) && isDigit(rest[exp]) {
+			end = exp
+			digits()
+		}
+	}
+	l.emit(tokNumber, strings.ReplaceAll(rest[:end], "_", ""))
+	l.pos += end
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isNameStart(c byte) bool { return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+func isNameChar(c byte) bool { return isNameStart(c) || isDigit(c) }
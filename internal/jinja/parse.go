@@ -0,0 +1,808 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jinja
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SyntaxError is returned for a template Home Assistant would refuse to
+// compile.
+type SyntaxError struct {
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// ErrUnsupported is returned for valid Jinja this package cannot evaluate,
+// such as macros or template inheritance.
+var ErrUnsupported = errors.New("unsupported")
+
+// Template is a parsed template.
+type Template struct {
+	nodes []node
+}
+
+// Parse parses a template. It returns a *SyntaxError for invalid syntax, or
+// an error wrapping ErrUnsupported for tags this package does not evaluate.
+func Parse(src string) (*Template, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	nodes, _, err := p.parseBody()
+	if err != nil {
+		return nil, err
+	}
+	return &Template{nodes: nodes}, nil
+}
+
+// Check returns a *SyntaxError if the template would be refused by Home
+// Assistant, and nil otherwise. Valid tags this package cannot evaluate are
+// not reported.
+func Check(src string) error {
+	_, err := Parse(src)
+	if errors.Is(err, ErrUnsupported) {
+		return nil
+	}
+	return err
+}
+
+// Statement nodes.
+type (
+	node interface{}
+
+	textNode struct {
+		text string
+	}
+	outputNode struct {
+		expr expr
+	}
+	ifNode struct {
+		conds  []expr
+		bodies [][]node
+		orElse []node
+	}
+	forNode struct {
+		targets []string
+		iter    expr
+		filter  expr
+		body    []node
+		orElse  []node
+	}
+	setNode struct {
+		name string
+		expr expr
+	}
+)
+
+// Expression nodes.
+type (
+	expr interface{}
+
+	literal struct {
+		value interface{}
+	}
+	nameExpr struct {
+		name string
+	}
+	listExpr struct {
+		items []expr
+	}
+	dictExpr struct {
+		keys, values []expr
+	}
+	attrExpr struct {
+		obj  expr
+		name string
+	}
+	itemExpr struct {
+		obj expr
+		key expr
+	}
+	sliceExpr struct {
+		obj               expr
+		start, stop, step expr
+	}
+	callExpr struct {
+		fn   expr
+		args callArgs
+	}
+	filterExpr struct {
+		value expr
+		name  string
+		args  callArgs
+	}
+	testExpr struct {
+		value  expr
+		name   string
+		args   callArgs
+		negate bool
+	}
+	unaryExpr struct {
+		op    string
+		value expr
+	}
+	binaryExpr struct {
+		op          string
+		left, right expr
+	}
+	compareExpr struct {
+		first    expr
+		ops      []string
+		operands []expr
+	}
+	condExpr struct {
+		cond, then, orElse expr
+	}
+)
+
+type callArgs struct {
+	args   []expr
+	kwargs map[string]expr
+}
+
+// unsupportedTags are valid Jinja statements this package does not
+// evaluate.
+var unsupportedTags = map[string]bool{
+	"macro": true, "call": true, "filter": true, "with": true, "raw": true, "block": true,
+	"extends": true, "include": true, "import": true, "from": true, "autoescape": true,
+	"do": true, "break": true, "continue": true,
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return &SyntaxError{Line: t.line, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) isOp(op string) bool {
+	t := p.peek()
+	return t.kind == tokOperator && t.val == op
+}
+
+func (p *parser) isName(name string) bool {
+	t := p.peek()
+	return t.kind == tokName && t.val == name
+}
+
+func (p *parser) expectOp(op string) error {
+	if t := p.next(); t.kind != tokOperator || t.val != op {
+		return p.errorf(t, "expected '%s', got %s", op, t)
+	}
+	return nil
+}
+
+func (p *parser) expect(kind tokenKind) error {
+	if t := p.next(); t.kind != kind {
+		return p.errorf(t, "expected %s, got %s", token{kind: kind}, t)
+	}
+	return nil
+}
+
+func (p *parser) expectName() (string, error) {
+	t := p.next()
+	if t.kind != tokName {
+		return "", p.errorf(t, "expected name, got %s", t)
+	}
+	return t.val, nil
+}
+
+// parseBody parses nodes up to one of the given end tags, which it returns
+// with the tag name consumed.
+func (p *parser) parseBody(endTags ...string) ([]node, string, error) {
+	var nodes []node
+	for {
+		t := p.next()
+		switch t.kind {
+		case tokEOF:
+			if len(endTags) > 0 {
+				return nil, "", p.errorf(t, "unexpected end of template, expected '%s'", strings.Join(endTags, "' or '"))
+			}
+			return nodes, "", nil
+		case tokText:
+			nodes = append(nodes, &textNode{text: t.val})
+		case tokVarBegin:
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, "", err
+			}
+			if err := p.expect(tokVarEnd); err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, &outputNode{expr: e})
+		case tokBlockBegin:
+			nameTok := p.next()
+			if nameTok.kind != tokName {
+				return nil, "", p.errorf(nameTok, "tag name expected")
+			}
+			for _, end := range endTags {
+				if nameTok.val == end {
+					return nodes, end, nil
+				}
+			}
+			n, err := p.parseStatement(nameTok)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, n)
+		default:
+			return nil, "", p.errorf(t, "unexpected %s", t)
+		}
+	}
+}
+
+func (p *parser) parseStatement(tag token) (node, error) {
+	switch tag.val {
+	case "if":
+		return p.parseIf()
+	case "for":
+		return p.parseFor()
+	case "set":
+		return p.parseSet()
+	}
+	if unsupportedTags[tag.val] {
+		return nil, fmt.Errorf("line %d: %s tag: %w", tag.line, tag.val, ErrUnsupported)
+	}
+	return nil, p.errorf(tag, "encountered unknown tag '%s'", tag.val)
+}
+
+func (p *parser) parseIf() (node, error) {
+	n := &ifNode{}
+	for {
+		cond, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokBlockEnd); err != nil {
+			return nil, err
+		}
+		body, end, err := p.parseBody("elif", "else", "endif")
+		if err != nil {
+			return nil, err
+		}
+		n.conds = append(n.conds, cond)
+		n.bodies = append(n.bodies, body)
+
+		switch end {
+		case "elif":
+			continue
+		case "else":
+			if err := p.expect(tokBlockEnd); err != nil {
+				return nil, err
+			}
+			n.orElse, _, err = p.parseBody("endif")
+			if err != nil {
+				return nil, err
+			}
+		}
+		return n, p.expect(tokBlockEnd)
+	}
+}
+
+func (p *parser) parseFor() (node, error) {
+	n := &forNode{}
+	for {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		n.targets = append(n.targets, name)
+		if !p.isOp(",") {
+			break
+		}
+		p.next()
+	}
+	if t := p.next(); t.kind != tokName || t.val != "in" {
+		return nil, p.errorf(t, "expected 'in', got %s", t)
+	}
+
+	var err error
+	if n.iter, err = p.parseOr(); err != nil {
+		return nil, err
+	}
+	if p.isName("if") {
+		p.next()
+		if n.filter, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.isName("recursive") {
+		return nil, fmt.Errorf("line %d: recursive loop: %w", p.peek().line, ErrUnsupported)
+	}
+	if err := p.expect(tokBlockEnd); err != nil {
+		return nil, err
+	}
+
+	body, end, err := p.parseBody("else", "endfor")
+	if err != nil {
+		return nil, err
+	}
+	n.body = body
+	if end == "else" {
+		if err := p.expect(tokBlockEnd); err != nil {
+			return nil, err
+		}
+		if n.orElse, _, err = p.parseBody("endfor"); err != nil {
+			return nil, err
+		}
+	}
+	return n, p.expect(tokBlockEnd)
+}
+
+func (p *parser) parseSet() (node, error) {
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOp("=") {
+		return nil, fmt.Errorf("line %d: set without a value: %w", p.peek().line, ErrUnsupported)
+	}
+	p.next()
+	e, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &setNode{name: name, expr: e}, p.expect(tokBlockEnd)
+}
+
+func (p *parser) parseExpr() (expr, error) {
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	for p.isName("if") {
+		p.next()
+		cond, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		var orElse expr
+		if p.isName("else") {
+			p.next()
+			if orElse, err = p.parseOr(); err != nil {
+				return nil, err
+			}
+		}
+		e = &condExpr{cond: cond, then: e, orElse: orElse}
+	}
+	return e, nil
+}
+
+func (p *parser) parseOr() (expr, error) {
+	return p.parseBinary([]string{"or"}, true, p.parseAnd)
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	return p.parseBinary([]string{"and"}, true, p.parseNot)
+}
+
+func (p *parser) parseNot() (expr, error) {
+	if p.isName("not") {
+		p.next()
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryExpr{op: "not", value: e}, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *parser) parseCompare() (expr, error) {
+	first, err := p.parseMath1()
+	if err != nil {
+		return nil, err
+	}
+	n := &compareExpr{first: first}
+	for {
+		t := p.peek()
+		var op string
+		switch {
+		case t.kind == tokOperator && (t.val == "==" || t.val == "!=" || t.val == "<" || t.val == ">" || t.val == "<=" || t.val == ">="):
+			op = t.val
+		case t.kind == tokName && t.val == "in":
+			op = "in"
+		case t.kind == tokName && t.val == "not" && p.tokens[p.pos+1].kind == tokName && p.tokens[p.pos+1].val == "in":
+			p.next()
+			op = "not in"
+		}
+		if op == "" {
+			break
+		}
+		p.next()
+		operand, err := p.parseMath1()
+		if err != nil {
+			return nil, err
+		}
+		n.ops = append(n.ops, op)
+		n.operands = append(n.operands, operand)
+	}
+	if len(n.ops) == 0 {
+		return first, nil
+	}
+	return n, nil
+}
+
+func (p *parser) parseMath1() (expr, error) {
+	return p.parseBinary([]string{"+", "-"}, false, p.parseConcat)
+}
+
+func (p *parser) parseConcat() (expr, error) {
+	return p.parseBinary([]string{"~"}, false, p.parseMath2)
+}
+
+func (p *parser) parseMath2() (expr, error) {
+	return p.parseBinary([]string{"*", "/", "//", "%"}, false, p.parsePow)
+}
+
+func (p *parser) parsePow() (expr, error) {
+	return p.parseBinary([]string{"**"}, false, func() (expr, error) { return p.parseUnary(true) })
+}
+
+// parseBinary parses left associative operators, given as names for
+// keywords and as operators otherwise.
+func (p *parser) parseBinary(ops []string, keyword bool, operand func() (expr, error)) (expr, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if keyword && t.kind != tokName || !keyword && t.kind != tokOperator || !slices.Contains(ops, t.val) {
+			return left, nil
+		}
+		p.next()
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{op: t.val, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary(withFilter bool) (expr, error) {
+	var e expr
+	var err error
+	switch {
+	case p.isOp("-"), p.isOp("+"):
+		op := p.next().val
+		value, err := p.parseUnary(false)
+		if err != nil {
+			return nil, err
+		}
+		e = &unaryExpr{op: op, value: value}
+	default:
+		if e, err = p.parsePrimary(); err != nil {
+			return nil, err
+		}
+	}
+	if e, err = p.parsePostfix(e); err != nil {
+		return nil, err
+	}
+	if withFilter {
+		return p.parseFilters(e)
+	}
+	return e, nil
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokName:
+		switch t.val {
+		case "true", "True":
+			return &literal{value: true}, nil
+		case "false", "False":
+			return &literal{value: false}, nil
+		case "none", "None":
+			return &literal{value: nil}, nil
+		}
+		return &nameExpr{name: t.val}, nil
+	case tokString:
+		s := t.val
+		for p.peek().kind == tokString {
+			s += p.next().val
+		}
+		return &literal{value: s}, nil
+	case tokNumber:
+		if i, err := strconv.Atoi(t.val); err == nil {
+			return &literal{value: i}, nil
+		}
+		f, err := strconv.ParseFloat(t.val, 64)
+		if err != nil {
+			return nil, p.errorf(t, "invalid number %s", t.val)
+		}
+		return &literal{value: f}, nil
+	case tokOperator:
+		switch t.val {
+		case "(":
+			if p.isOp(")") {
+				p.next()
+				return &listExpr{}, nil
+			}
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if !p.isOp(",") {
+				return e, p.expectOp(")")
+			}
+			items := []expr{e}
+			for p.isOp(",") {
+				p.next()
+				if p.isOp(")") {
+					break
+				}
+				item, err := p.parseExpr()
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			return &listExpr{items: items}, p.expectOp(")")
+		case "[":
+			items, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listExpr{items: items}, nil
+		case "{":
+			return p.parseDict()
+		}
+	}
+	return nil, p.errorf(t, "unexpected %s", t)
+}
+
+// parseList parses comma separated expressions up to the closing operator.
+func (p *parser) parseList(closing string) ([]expr, error) {
+	var items []expr
+	for !p.isOp(closing) {
+		if len(items) > 0 {
+			if err := p.expectOp(","); err != nil {
+				return nil, err
+			}
+			if p.isOp(closing) {
+				break
+			}
+		}
+		item, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	p.next()
+	return items, nil
+}
+
+func (p *parser) parseDict() (expr, error) {
+	d := &dictExpr{}
+	for !p.isOp("}") {
+		if len(d.keys) > 0 {
+			if err := p.expectOp(","); err != nil {
+				return nil, err
+			}
+			if p.isOp("}") {
+				break
+			}
+		}
+		key, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectOp(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		d.keys = append(d.keys, key)
+		d.values = append(d.values, value)
+	}
+	p.next()
+	return d, nil
+}
+
+func (p *parser) parsePostfix(e expr) (expr, error) {
+	for {
+		switch {
+		case p.isOp("."):
+			p.next()
+			t := p.next()
+			switch t.kind {
+			case tokName:
+				e = &attrExpr{obj: e, name: t.val}
+			case tokNumber:
+				i, err := strconv.Atoi(t.val)
+				if err != nil {
+					return nil, p.errorf(t, "invalid attribute %s", t.val)
+				}
+				e = &itemExpr{obj: e, key: &literal{value: i}}
+			default:
+				return nil, p.errorf(t, "expected name or number, got %s", t)
+			}
+		case p.isOp("["):
+			p.next()
+			var err error
+			if e, err = p.parseSubscript(e); err != nil {
+				return nil, err
+			}
+		case p.isOp("("):
+			p.next()
+			args, err := p.parseArgs()
+			if err != nil {
+				return nil, err
+			}
+			e = &callExpr{fn: e, args: args}
+		default:
+			return e, nil
+		}
+	}
+}
+
+func (p *parser) parseSubscript(obj expr) (expr, error) {
+	var parts [3]expr
+	n := 0
+	for {
+		if !p.isOp(":") && !p.isOp("]") {
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			parts[n] = e
+		}
+		if !p.isOp(":") || n == 2 {
+			break
+		}
+		p.next()
+		n++
+	}
+	if err := p.expectOp("]"); err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		if parts[0] == nil {
+			return nil, p.errorf(p.tokens[p.pos-1], "expected subscript")
+		}
+		return &itemExpr{obj: obj, key: parts[0]}, nil
+	}
+	return &sliceExpr{obj: obj, start: parts[0], stop: parts[1], step: parts[2]}, nil
+}
+
+// parseArgs parses call arguments after the opening parenthesis.
+func (p *parser) parseArgs() (callArgs, error) {
+	var args callArgs
+	for !p.isOp(")") {
+		if len(args.args)+len(args.kwargs) > 0 {
+			if err := p.expectOp(","); err != nil {
+				return args, err
+			}
+			if p.isOp(")") {
+				break
+			}
+		}
+		t := p.peek()
+		if t.kind == tokName && p.tokens[p.pos+1].kind == tokOperator && p.tokens[p.pos+1].val == "=" {
+			p.pos += 2
+			value, err := p.parseExpr()
+			if err != nil {
+				return args, err
+			}
+			if args.kwargs == nil {
+				args.kwargs = make(map[string]expr)
+			}
+			args.kwargs[t.val] = value
+			continue
+		}
+		if len(args.kwargs) > 0 {
+			return args, p.errorf(t, "positional argument follows keyword argument")
+		}
+		value, err := p.parseExpr()
+		if err != nil {
+			return args, err
+		}
+		args.args = append(args.args, value)
+	}
+	p.next()
+	return args, nil
+}
+
+func (p *parser) parseFilters(e expr) (expr, error) {
+	for {
+		switch {
+		case p.isOp("|"):
+			p.next()
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			f := &filterExpr{value: e, name: name}
+			if p.isOp("(") {
+				p.next()
+				if f.args, err = p.parseArgs(); err != nil {
+					return nil, err
+				}
+			}
+			e = f
+		case p.isName("is"):
+			p.next()
+			t := &testExpr{value: e}
+			if p.isName("not") {
+				p.next()
+				t.negate = true
+			}
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			t.name = name
+			next := p.peek()
+			switch {
+			case p.isOp("("):
+				p.next()
+				if t.args, err = p.parseArgs(); err != nil {
+					return nil, err
+				}
+			case next.kind == tokString || next.kind == tokNumber || p.isOp("[") ||
+				next.kind == tokName && !slices.Contains([]string{"else", "or", "and", "if", "is", "in", "not"}, next.val):
+				arg, err := p.parsePrimary()
+				if err != nil {
+					return nil, err
+				}
+				if arg, err = p.parsePostfix(arg); err != nil {
+					return nil, err
+				}
+				t.args.args = []expr{arg}
+			}
+			e = t
+		case p.isOp("("):
+			p.next()
+			args, err := p.parseArgs()
+			if err != nil {
+				return nil, err
+			}
+			e = &callExpr{fn: e, args: args}
+		default:
+			return e, nil
+		}
+	}
+}
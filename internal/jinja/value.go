@@ -0,0 +1,262 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jinja
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Undefined is the value of a missing variable, attribute or item. It
+// renders as an empty string, like Home Assistant's default.
+type Undefined struct {
+	Name string
+}
+
+// method is a value bound to one of its methods, such as str.split.
+type method func(args []interface{}, kwargs map[string]interface{}) (interface{}, error)
+
+// function is a global such as float or iif.
+type function func(args []interface{}, kwargs map[string]interface{}) (interface{}, error)
+
+// PayloadVars returns the variables Home Assistant passes to templates
+// rendering an MQTT payload: value, and value_json when the payload is JSON.
+func PayloadVars(payload string) map[string]interface{} {
+	vars := map[string]interface{}{"value": payload}
+	if v, err := FromJSON(payload); err == nil {
+		vars["value_json"] = v
+	}
+	return vars
+}
+
+// FromJSON decodes JSON into template values, keeping whole numbers as
+// integers.
+func FromJSON(s string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return fromJSON(v), nil
+}
+
+func fromJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := strconv.Atoi(v.String()); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = fromJSON(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = fromJSON(v[k])
+		}
+	}
+	return v
+}
+
+// toString converts a value like Python's str.
+func toString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case Undefined:
+		return ""
+	}
+	return repr(v)
+}
+
+// repr converts a value like Python's repr.
+func repr(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "None"
+	case Undefined:
+		return ""
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return formatFloat(v)
+	case string:
+		return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`, "\n", `\n`).Replace(v) + "'"
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = repr(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		keys := sortedKeys(v)
+		items := make([]string, len(keys))
+		for i, k := range keys {
+			items[i] = repr(k) + ": " + repr(v[k])
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	}
+	return fmt.Sprint(v)
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-4 || abs >= 1e16) {
+		return strconv.FormatFloat(f, 'e', -1, 64)
+	}
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "NoneType"
+	case Undefined:
+		return "Undefined"
+	case bool:
+		return "bool"
+	case int:
+		return "int"
+	case float64:
+		return "float"
+	case string:
+		return "str"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "dict"
+	}
+	return "function"
+}
+
+func truthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil, Undefined:
+		return false
+	case bool:
+		return v
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return true
+}
+
+// toNumber returns the value of ints, floats and booleans.
+func toNumber(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func isNumber(v interface{}) bool {
+	switch v.(type) {
+	case int, float64:
+		return true
+	}
+	return false
+}
+
+func equal(a, b interface{}) bool {
+	if x, ok := toNumber(a); ok {
+		if y, ok := toNumber(b); ok {
+			return x == y
+		}
+		return false
+	}
+	if _, ok := a.(Undefined); ok {
+		_, ok := b.(Undefined)
+		return ok
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// iterate returns the items of a list, the characters of a string or the
+// keys of a dict.
+func iterate(v interface{}) ([]interface{}, error) {
+	switch v := v.(type) {
+	case []interface{}:
+		return v, nil
+	case string:
+		items := make([]interface{}, 0, len(v))
+		for _, r := range v {
+			items = append(items, string(r))
+		}
+		return items, nil
+	case map[string]interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, k := range sortedKeys(v) {
+			items = append(items, k)
+		}
+		return items, nil
+	case Undefined:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("'%s' object is not iterable", typeName(v))
+}
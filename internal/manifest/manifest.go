@@ -0,0 +1,202 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifest reads hass-crds resources from YAML and JSON manifests.
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	mqttv1beta1 "github.com/spontus/hass-crds/api/v1beta1"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// Scheme knows the hass-crds kinds read from manifests, and Namespaces,
+// whose labels the defaults may select.
+var Scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(corev1.AddToScheme(Scheme))
+	utilruntime.Must(mqttv1alpha1.AddToScheme(Scheme))
+	utilruntime.Must(mqttv1beta1.AddToScheme(Scheme))
+}
+
+// Set is the hass-crds resources read from YAML or JSON files.
+type Set struct {
+	// Entities are the resources published as discovery messages, in input order.
+	Entities []client.Object
+	// Devices are the MQTTDevice resources entities may reference.
+	Devices []client.Object
+	// Defaults are the MQTTDefaults and ClusterMQTTDefaults merged into entities.
+	Defaults []client.Object
+}
+
+// Load reads resources from paths, or from stdin if paths is empty or "-".
+// Directories are read recursively for .yaml, .yml and .json files, skipping
+// hidden files and directories. Documents of other API groups are ignored;
+// resources without a namespace are placed in namespace, except the
+// cluster-scoped ClusterMQTTDefaults. A resource defined twice is an error.
+// Resources of every served version are converted to v1alpha1, the version
+// the controllers work with.
+func Load(paths []string, stdin io.Reader, namespace string) (*Set, error) {
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	m := &Set{}
+	for _, path := range paths {
+		if path == "-" {
+			if err := m.read("stdin", stdin, namespace); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if p != path && strings.HasPrefix(d.Name(), ".") {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.IsDir() {
+				return nil
+			}
+			// Files named explicitly are read whatever their extension
+			if p != path && !IsManifestFile(p) {
+				return nil
+			}
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			return m.read(p, bytes.NewReader(data), namespace)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// IsManifestFile reports whether path has the extension of a manifest file.
+func IsManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// read decodes every document of r, named source in errors.
+func (m *Set) read(source string, r io.Reader, namespace string) error {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for i := 1; ; i++ {
+		u := &unstructured.Unstructured{}
+		if err := decoder.Decode(&u.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("%s: document %d: %w", source, i, err)
+		}
+		if len(u.Object) == 0 {
+			continue
+		}
+
+		gvk := u.GroupVersionKind()
+		if gvk.Group != mqttv1alpha1.GroupVersion.Group {
+			continue
+		}
+		if u.GetNamespace() == "" && gvk.Kind != "ClusterMQTTDefaults" {
+			u.SetNamespace(namespace)
+		}
+
+		obj, err := Scheme.New(gvk)
+		if err != nil {
+			return fmt.Errorf("%s: document %d: %w", source, i, err)
+		}
+		// Unknown fields are errors, as the API server would reject them
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(u.Object, obj, true); err != nil {
+			return fmt.Errorf("%s: %s %s/%s: %w", source, gvk.Kind, u.GetNamespace(), u.GetName(), err)
+		}
+		if hub, ok := obj.(conversion.Hub); ok {
+			if obj, err = toV1alpha1(hub); err != nil {
+				return fmt.Errorf("%s: %s %s/%s: %w", source, gvk.Kind, u.GetNamespace(), u.GetName(), err)
+			}
+		}
+
+		switch {
+		case gvk.Kind == "MQTTDevice":
+			if defined(m.Devices, u) {
+				return fmt.Errorf("%s: MQTTDevice %s/%s is defined twice", source, u.GetNamespace(), u.GetName())
+			}
+			m.Devices = append(m.Devices, obj.(client.Object))
+		case gvk.Kind == "MQTTDefaults" || gvk.Kind == "ClusterMQTTDefaults":
+			if defined(m.Defaults, u) {
+				return fmt.Errorf("%s: %s %s is defined twice", source, gvk.Kind, client.ObjectKeyFromObject(obj.(client.Object)))
+			}
+			m.Defaults = append(m.Defaults, obj.(client.Object))
+		case registry.Lookup(gvk.Kind).IsEntity():
+			if defined(m.Entities, u) {
+				return fmt.Errorf("%s: %s %s/%s is defined twice", source, gvk.Kind, u.GetNamespace(), u.GetName())
+			}
+			m.Entities = append(m.Entities, obj.(client.Object))
+		}
+	}
+}
+
+// toV1alpha1 converts hub to its v1alpha1 kind.
+func toV1alpha1(hub conversion.Hub) (runtime.Object, error) {
+	gvk := hub.GetObjectKind().GroupVersionKind()
+	obj, err := Scheme.New(mqttv1alpha1.GroupVersion.WithKind(gvk.Kind))
+	if err != nil {
+		return nil, err
+	}
+	if err := obj.(conversion.Convertible).ConvertFrom(hub); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// defined reports whether objs holds a resource of u's kind, namespace and name.
+func defined(objs []client.Object, u *unstructured.Unstructured) bool {
+	for _, o := range objs {
+		if reflect.TypeOf(o).Elem().Name() == u.GetKind() && o.GetNamespace() == u.GetNamespace() && o.GetName() == u.GetName() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+const testManifests = `apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: MQTTDevice
+metadata:
+  name: garage
+  namespace: home
+spec:
+  name: Garage
+  identifiers: ["garage-1"]
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+---
+apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: MQTTSwitch
+metadata:
+  name: door
+  namespace: home
+spec:
+  commandTopic: garage/door/set
+  deviceRef:
+    name: garage
+`
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(testManifests), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a manifest"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".hidden.yaml"), []byte(testManifests), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := Load([]string{dir}, nil, "lab")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if len(m.Devices) != 1 || len(m.Entities) != 1 {
+		t.Fatalf("got %d devices and %d entities, want 1 and 1", len(m.Devices), len(m.Entities))
+	}
+	if m.Devices[0].GetNamespace() != "home" || m.Entities[0].GetNamespace() != "home" {
+		t.Errorf("namespaces = %q, %q, want home, home", m.Devices[0].GetNamespace(), m.Entities[0].GetNamespace())
+	}
+
+	// Resources without a namespace get the default one
+	m, err = Load(nil, strings.NewReader("apiVersion: mqtt.home-assistant.io/v1alpha1\nkind: MQTTButton\nmetadata:\n  name: a\nspec:\n  commandTopic: x\n"), "lab")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if got := m.Entities[0].GetNamespace(); got != "lab" {
+		t.Errorf("namespace = %q, want lab", got)
+	}
+}
+
+func TestLoad_V1beta1(t *testing.T) {
+	input := `apiVersion: mqtt.home-assistant.io/v1beta1
+kind: MQTTLight
+metadata:
+  name: desk
+spec:
+  commandTopic: desk/set
+  schema: json
+  device:
+    connections:
+      - type: mac
+        value: aa:bb:cc:dd:ee:ff
+  json:
+    brightness: true
+`
+	m, err := Load(nil, strings.NewReader(input), "lab")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	light, ok := m.Entities[0].(*mqttv1alpha1.MQTTLight)
+	if !ok {
+		t.Fatalf("entity is %T, want *v1alpha1.MQTTLight", m.Entities[0])
+	}
+	if light.Spec.Brightness == nil || !*light.Spec.Brightness {
+		t.Errorf("brightness = %v, want true", light.Spec.Brightness)
+	}
+	if got := light.Spec.Device.Connections; len(got) != 1 || got[0][0] != "mac" || got[0][1] != "aa:bb:cc:dd:ee:ff" {
+		t.Errorf("connections = %v, want [[mac aa:bb:cc:dd:ee:ff]]", got)
+	}
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	input := `apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: MQTTDefaults
+metadata:
+  name: common
+spec:
+  qos: 0
+---
+apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: ClusterMQTTDefaults
+metadata:
+  name: site
+spec:
+  entityCategory: diagnostic
+`
+	m, err := Load(nil, strings.NewReader(input), "lab")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if len(m.Defaults) != 2 || len(m.Entities) != 0 {
+		t.Fatalf("got %d defaults and %d entities, want 2 and 0", len(m.Defaults), len(m.Entities))
+	}
+	// ClusterMQTTDefaults are cluster-scoped
+	if m.Defaults[0].GetNamespace() != "lab" || m.Defaults[1].GetNamespace() != "" {
+		t.Errorf("namespaces = %q, %q, want lab and none", m.Defaults[0].GetNamespace(), m.Defaults[1].GetNamespace())
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unknown field", "apiVersion: mqtt.home-assistant.io/v1alpha1\nkind: MQTTButton\nmetadata:\n  name: a\nspec:\n  comandTopic: x\n"},
+		{"unknown kind", "apiVersion: mqtt.home-assistant.io/v1alpha1\nkind: MQTTToaster\nmetadata:\n  name: a\n"},
+		{"duplicate entity", "apiVersion: mqtt.home-assistant.io/v1alpha1\nkind: MQTTButton\nmetadata:\n  name: a\n---\napiVersion: mqtt.home-assistant.io/v1alpha1\nkind: MQTTButton\nmetadata:\n  name: a\n"},
+		{"duplicate device", "apiVersion: mqtt.home-assistant.io/v1alpha1\nkind: MQTTDevice\nmetadata:\n  name: a\n---\napiVersion: mqtt.home-assistant.io/v1alpha1\nkind: MQTTDevice\nmetadata:\n  name: a\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Load(nil, strings.NewReader(tt.input), "default"); err == nil {
+				t.Error("Load() succeeded, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+// entityListTimeout bounds the time a scrape spends listing entities.
+const entityListTimeout = 10 * time.Second
+
+var entitiesDesc = prometheus.NewDesc(
+	namespace+"_entities",
+	"Entity resources, by kind, namespace and status of the Published condition (True, False, Unknown).",
+	[]string{"kind", "namespace", "published"}, nil,
+)
+
+// EntityCollector reports the number of entity resources at scrape time.
+// It lists through the manager's cache, which the entity controllers
+// already keep populated, so a scrape does not hit the API server.
+type EntityCollector struct {
+	reader client.Reader
+	scheme *runtime.Scheme
+	log    logr.Logger
+}
+
+// NewEntityCollector creates an EntityCollector that lists entities with reader.
+func NewEntityCollector(reader client.Reader, scheme *runtime.Scheme, log logr.Logger) *EntityCollector {
+	return &EntityCollector{
+		reader: reader,
+		scheme: scheme,
+		log:    log.WithName("metrics"),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *EntityCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- entitiesDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *EntityCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), entityListTimeout)
+	defer cancel()
+
+	type key struct{ kind, namespace, published string }
+
+	for _, k := range registry.Entities() {
+		kind := k.Kind
+		obj, err := c.scheme.New(mqttv1alpha1.GroupVersion.WithKind(kind + "List"))
+		if err != nil {
+			continue
+		}
+		list, ok := obj.(client.ObjectList)
+		if !ok {
+			continue
+		}
+		if err := c.reader.List(ctx, list); err != nil {
+			c.log.V(1).Info("Failed to list entities for metrics", "kind", kind, "error", err.Error())
+			continue
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			continue
+		}
+
+		counts := make(map[key]int)
+		for _, item := range items {
+			o, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			counts[key{kind, o.GetNamespace(), publishedStatus(item)}]++
+		}
+
+		for k, n := range counts {
+			ch <- prometheus.MustNewConstMetric(entitiesDesc, prometheus.GaugeValue, float64(n), k.kind, k.namespace, k.published)
+		}
+	}
+}
+
+// publishedStatus returns the status of obj's Published condition, or Unknown.
+func publishedStatus(obj runtime.Object) string {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return mqttv1alpha1.ConditionUnknown
+	}
+	conditions, _, _ := unstructured.NestedSlice(u, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["type"] != mqttv1alpha1.ConditionTypePublished {
+			continue
+		}
+		if status, ok := cond["status"].(string); ok {
+			return status
+		}
+	}
+	return mqttv1alpha1.ConditionUnknown
+}
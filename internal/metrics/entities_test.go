@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+func TestEntityCollector(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := mqttv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	published := mqttv1alpha1.MQTTButtonStatus{CommonStatus: mqttv1alpha1.CommonStatus{
+		Conditions: []mqttv1alpha1.Condition{{Type: mqttv1alpha1.ConditionTypePublished, Status: mqttv1alpha1.ConditionTrue}},
+	}}
+	failed := mqttv1alpha1.MQTTButtonStatus{CommonStatus: mqttv1alpha1.CommonStatus{
+		Conditions: []mqttv1alpha1.Condition{{Type: mqttv1alpha1.ConditionTypePublished, Status: mqttv1alpha1.ConditionFalse}},
+	}}
+
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&mqttv1alpha1.MQTTButton{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "home"}, Status: published},
+		&mqttv1alpha1.MQTTButton{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "home"}, Status: published},
+		&mqttv1alpha1.MQTTButton{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "garage"}, Status: failed},
+		&mqttv1alpha1.MQTTSensor{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "home"}},
+	).Build()
+
+	collector := NewEntityCollector(reader, scheme, logr.Discard())
+
+	expected := `
+# HELP hass_crds_entities Entity resources, by kind, namespace and status of the Published condition (True, False, Unknown).
+# TYPE hass_crds_entities gauge
+hass_crds_entities{kind="MQTTButton",namespace="garage",published="False"} 1
+hass_crds_entities{kind="MQTTButton",namespace="home",published="True"} 2
+hass_crds_entities{kind="MQTTSensor",namespace="home",published="Unknown"} 1
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines the Prometheus metrics exported by the controller.
+// They are registered with controller-runtime's registry and served by the
+// manager's metrics endpoint.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "hass_crds"
+
+// Values of the result label.
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+	ResultQueued  = "queued"
+	ResultRefused = "refused"
+)
+
+var (
+	// PublishesTotal counts discovery publishes (including deletions) by kind and result.
+	PublishesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "publishes_total",
+		Help:      "Discovery messages published, by entity kind and result (success, error, queued).",
+	}, []string{"kind", "result"})
+
+	// PublishDuration observes how long delivered or failed publishes took.
+	PublishDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "publish_duration_seconds",
+		Help:      "Time taken to publish a discovery message, by entity kind.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"kind"})
+
+	// MQTTConnected is 1 while the MQTT client is connected to the broker.
+	MQTTConnected = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "mqtt_connected",
+		Help:      "Whether the MQTT client is connected to the broker (1) or not (0).",
+	})
+
+	// MQTTReconnectsTotal counts automatic reconnection attempts.
+	MQTTReconnectsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "mqtt_reconnects_total",
+		Help:      "MQTT reconnection attempts after a lost connection.",
+	})
+
+	// MQTTConnectionWait observes how long publishes waited in WaitForConnection.
+	MQTTConnectionWait = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "mqtt_connection_wait_seconds",
+		Help:      "Time spent waiting for the MQTT connection before publishing, when not connected.",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 20, 30},
+	})
+
+	// OutboxDepth is the number of messages queued in the publish outbox.
+	OutboxDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "mqtt_outbox_depth",
+		Help:      "Messages queued in the publish outbox waiting for delivery.",
+	})
+
+	// OutboxDroppedTotal counts queued messages the broker refused for good.
+	OutboxDroppedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "mqtt_outbox_dropped_total",
+		Help:      "Queued messages dropped from the publish outbox because the broker refused them with a permanent reason code.",
+	})
+
+	// GCCyclesTotal counts garbage collection cycles by result.
+	GCCyclesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gc_cycles_total",
+		Help:      "Orphan garbage collection cycles, by result (success, error, refused).",
+	}, []string{"result"})
+
+	// GCOrphansFoundTotal counts orphaned discovery topics found.
+	GCOrphansFoundTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gc_orphans_found_total",
+		Help:      "Orphaned discovery topics found by the garbage collector.",
+	})
+
+	// GCOrphansRemovedTotal counts orphaned discovery topics removed.
+	GCOrphansRemovedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gc_orphans_removed_total",
+		Help:      "Orphaned discovery topics removed by the garbage collector.",
+	})
+
+	// GCLastSuccess is the Unix time of the last successful garbage collection cycle.
+	GCLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "gc_last_success_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful garbage collection cycle.",
+	})
+
+	// GCOrphansPending is the number of orphans waiting out the grace period.
+	GCOrphansPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "gc_orphans_pending",
+		Help:      "Orphaned discovery topics seen for fewer than GC_GRACE_CYCLES consecutive cycles.",
+	})
+
+	// GCDeletionsRefusedTotal counts cycles whose deletions exceeded the deletion cap.
+	GCDeletionsRefusedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gc_deletions_refused_total",
+		Help:      "Garbage collection cycles that removed nothing because the orphans exceeded the deletion cap.",
+	})
+
+	// DriftDetectedTotal counts retained discovery configs found overwritten or cleared, by kind.
+	DriftDetectedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "drift_detected_total",
+		Help:      "Retained discovery configs that no longer matched their resource.",
+	}, []string{"kind"})
+
+	// DriftRepairsTotal counts discovery configs re-published after drift, by kind.
+	DriftRepairsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "drift_repairs_total",
+		Help:      "Discovery configs re-published to repair drift.",
+	}, []string{"kind"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		PublishesTotal,
+		PublishDuration,
+		MQTTConnected,
+		MQTTReconnectsTotal,
+		MQTTConnectionWait,
+		OutboxDepth,
+		OutboxDroppedTotal,
+		GCCyclesTotal,
+		GCOrphansFoundTotal,
+		GCOrphansRemovedTotal,
+		GCLastSuccess,
+		GCOrphansPending,
+		GCDeletionsRefusedTotal,
+		DriftDetectedTotal,
+		DriftRepairsTotal,
+	)
+}
@@ -25,6 +25,8 @@ import (
 
 	pahomqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/go-logr/logr"
+
+	"github.com/spontus/hass-crds/internal/metrics"
 )
 
 const (
@@ -42,6 +44,10 @@ const (
 
 	// DefaultReconnectWaitTimeout is how long Publish waits for reconnection.
 	DefaultReconnectWaitTimeout = 30 * time.Second
+
+	// DefaultDrainTimeout is how long a client rebuild waits for in-flight
+	// publishes on the old connection to complete before disconnecting it.
+	DefaultDrainTimeout = 10 * time.Second
 )
 
 // MessageHandler is a callback for received MQTT messages.
@@ -56,35 +62,91 @@ type Client interface {
 	Unsubscribe(ctx context.Context, topics ...string) error
 	IsConnected() bool
 	WaitForConnection(ctx context.Context) error
+	// LastError returns the most recent connection error, or nil if the
+	// last connection attempt succeeded.
+	LastError() error
 }
 
 // PahoClient wraps the Paho MQTT client.
 type PahoClient struct {
+	log logr.Logger
+
+	// lifecycleMu serializes Connect, UpdateCredentials and Disconnect.
+	// It is never taken by Publish or by paho callbacks.
+	lifecycleMu sync.Mutex
+
+	// mu guards the fields below. It is never held while connecting or
+	// draining, so IsConnected and LastError answer during a rebuild.
+	mu            sync.RWMutex
 	client        pahomqtt.Client
 	config        *Config
-	log           logr.Logger
-	mu            sync.RWMutex
 	disconnecting bool
+	rebuilding    bool
+	lastErr       error
+
+	// inflight tracks publishes issued on the current paho client so that a
+	// rebuild can drain them before disconnecting. Add is only called under
+	// mu while rebuilding is false, and Wait only after setting it.
+	inflight sync.WaitGroup
+
+	// subscriptions are restored whenever a connection is established, since
+	// the clean session drops them on the broker side.
+	subMu         sync.Mutex
+	subscriptions map[string]pahoSubscription
+}
+
+// pahoSubscription is an active subscription restored after reconnecting.
+type pahoSubscription struct {
+	qos     byte
+	handler MessageHandler
 }
 
 // NewClient creates a new MQTT client with the given configuration.
 func NewClient(config *Config, log logr.Logger) *PahoClient {
 	return &PahoClient{
-		config: config,
-		log:    log.WithName("mqtt-client"),
+		config:        config,
+		log:           log.WithName("mqtt-client"),
+		subscriptions: make(map[string]pahoSubscription),
+	}
+}
+
+// New creates the client for config.ProtocolVersion: a V5Client for MQTT v5,
+// otherwise a PahoClient speaking MQTT 3.1.1.
+func New(config *Config, log logr.Logger) Client {
+	if config.ProtocolVersion == ProtocolVersion5 {
+		return NewV5Client(config, log)
 	}
+	return NewClient(config, log)
 }
 
 // Connect establishes the MQTT connection.
 func (c *PahoClient) Connect(ctx context.Context) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
 
+	c.mu.Lock()
 	c.disconnecting = false
+	config := c.config
+	client := c.newPahoClient(config, true)
+	c.client = client
+	c.mu.Unlock()
+
+	if err := c.connect(ctx, client); err != nil {
+		return err
+	}
 
+	c.log.Info("MQTT client connected", "broker", config.BrokerURL())
+	return nil
+}
+
+// newPahoClient builds a paho client from config.
+// When connectRetry is false the initial Connect fails fast with the broker's
+// error instead of retrying in the background.
+func (c *PahoClient) newPahoClient(config *Config, connectRetry bool) pahomqtt.Client {
+	broker := config.BrokerURL()
 	opts := pahomqtt.NewClientOptions()
-	opts.AddBroker(c.config.BrokerURL())
-	opts.SetClientID(c.config.ClientID)
+	opts.AddBroker(broker)
+	opts.SetClientID(config.ClientID)
 	opts.SetCleanSession(true)
 	opts.SetKeepAlive(DefaultKeepAlive)
 	opts.SetWriteTimeout(DefaultWriteTimeout)
@@ -92,55 +154,183 @@ func (c *PahoClient) Connect(ctx context.Context) error {
 
 	// Auto-reconnect settings
 	opts.SetAutoReconnect(true)
-	opts.SetConnectRetry(true)
+	opts.SetConnectRetry(connectRetry)
 	opts.SetConnectRetryInterval(5 * time.Second)
 	opts.SetMaxReconnectInterval(DefaultMaxReconnectInterval)
 
-	if c.config.Username != "" {
-		opts.SetUsername(c.config.Username)
-		opts.SetPassword(c.config.Password)
+	if config.Username != "" {
+		opts.SetUsername(config.Username)
+		opts.SetPassword(config.Password)
 	}
 
 	opts.SetConnectionLostHandler(func(client pahomqtt.Client, err error) {
-		c.log.Error(err, "MQTT connection lost, will auto-reconnect", "broker", c.config.BrokerURL())
+		c.setLastError(err)
+		metrics.MQTTConnected.Set(0)
+		c.log.Error(err, "MQTT connection lost, will auto-reconnect", "broker", broker)
 	})
 
 	opts.SetOnConnectHandler(func(client pahomqtt.Client) {
-		c.log.Info("MQTT connected", "broker", c.config.BrokerURL())
+		c.setLastError(nil)
+		metrics.MQTTConnected.Set(1)
+		c.log.Info("MQTT connected", "broker", broker)
+		c.resubscribe(client)
 	})
 
 	opts.SetReconnectingHandler(func(client pahomqtt.Client, opts *pahomqtt.ClientOptions) {
-		c.log.Info("MQTT attempting reconnection", "broker", c.config.BrokerURL())
+		metrics.MQTTReconnectsTotal.Inc()
+		c.log.Info("MQTT attempting reconnection", "broker", broker)
 	})
 
-	c.client = pahomqtt.NewClient(opts)
+	return pahomqtt.NewClient(opts)
+}
 
-	token := c.client.Connect()
+// resubscribe restores all subscriptions on client after a (re)connect.
+// Paho runs the OnConnect handler in its own goroutine, so waiting for the
+// SUBACKs here does not block the connection.
+func (c *PahoClient) resubscribe(client pahomqtt.Client) {
+	c.subMu.Lock()
+	subs := make(map[string]pahoSubscription, len(c.subscriptions))
+	for filter, sub := range c.subscriptions {
+		subs[filter] = sub
+	}
+	c.subMu.Unlock()
+
+	for filter, sub := range subs {
+		token := client.Subscribe(filter, sub.qos, pahoHandler(sub.handler))
+		if !token.WaitTimeout(DefaultWriteTimeout) {
+			c.log.Info("Timed out restoring MQTT subscription", "topic", filter)
+			continue
+		}
+		if err := token.Error(); err != nil {
+			c.log.Error(err, "Failed to restore MQTT subscription", "topic", filter)
+			continue
+		}
+		c.log.V(1).Info("Restored MQTT subscription", "topic", filter)
+	}
+}
+
+// pahoHandler adapts a MessageHandler to a paho message callback.
+func pahoHandler(handler MessageHandler) pahomqtt.MessageHandler {
+	return func(_ pahomqtt.Client, msg pahomqtt.Message) {
+		handler(msg.Topic(), msg.Payload())
+	}
+}
+
+// connect connects client and waits for the result.
+func (c *PahoClient) connect(ctx context.Context, client pahomqtt.Client) error {
+	token := client.Connect()
 
 	// Wait for connection with context timeout
 	select {
 	case <-ctx.Done():
+		c.setLastError(ctx.Err())
 		return ctx.Err()
 	case <-token.Done():
 		if token.Error() != nil {
+			c.setLastError(token.Error())
 			return fmt.Errorf("failed to connect to MQTT broker: %w", token.Error())
 		}
 	}
 
-	c.log.Info("MQTT client connected", "broker", c.config.BrokerURL())
+	c.setLastError(nil)
+	return nil
+}
+
+// UpdateCredentials replaces the username and password used to authenticate
+// with the broker and rebuilds the underlying paho client.
+//
+// New publishes are held back while the rebuild is in progress, publishes
+// already in flight on the old connection are drained (up to
+// DefaultDrainTimeout) and the old connection is closed before the new one is
+// opened, so the broker never sees two sessions with the same client ID.
+// If the new credentials are rejected, the error is returned and recorded in
+// LastError, and the client keeps retrying in the background.
+func (c *PahoClient) UpdateCredentials(ctx context.Context, username, password string) error {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
+	c.mu.Lock()
+	if c.disconnecting {
+		c.mu.Unlock()
+		return fmt.Errorf("MQTT client is disconnecting")
+	}
+	if c.config.Username == username && c.config.Password == password {
+		c.mu.Unlock()
+		return nil
+	}
+	cfg := *c.config
+	cfg.Username = username
+	cfg.Password = password
+	c.config = &cfg
+	old := c.client
+	// New publishes wait for the new client, so none start while draining.
+	c.rebuilding = true
+	c.mu.Unlock()
+
+	c.log.Info("MQTT credentials changed, rebuilding client", "broker", cfg.BrokerURL(), "username", username)
+
+	if !waitTimeout(&c.inflight, DefaultDrainTimeout) {
+		c.log.Info("Timed out draining in-flight publishes before rebuild", "timeout", DefaultDrainTimeout)
+	}
+
+	// Stop the old client even if it is not connected: after the broker
+	// rejected the old password it is in its reconnect loop, and would keep
+	// reconnecting under the same client ID as the new one.
+	if old != nil {
+		old.Disconnect(1000) // 1 second quiesce
+	}
+
+	client := c.newPahoClient(&cfg, false)
+	err := c.connect(ctx, client)
+	if err != nil {
+		c.log.Error(err, "MQTT reconnect with new credentials failed, will keep retrying")
+		metrics.MQTTConnected.Set(0)
+		client.Disconnect(0)
+		client = c.newPahoClient(&cfg, true)
+		client.Connect()
+	}
+
+	c.mu.Lock()
+	c.client = client
+	c.rebuilding = false
+	c.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	c.log.Info("MQTT client reconnected with new credentials", "broker", cfg.BrokerURL())
 	return nil
 }
 
+// LastError returns the most recent connection error, or nil if connected.
+func (c *PahoClient) LastError() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastErr
+}
+
+func (c *PahoClient) setLastError(err error) {
+	c.mu.Lock()
+	c.lastErr = err
+	c.mu.Unlock()
+}
+
 // Disconnect closes the MQTT connection.
 func (c *PahoClient) Disconnect() {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
 	c.mu.Lock()
 	c.disconnecting = true
+	client := c.client
+	c.mu.Unlock()
 
-	if c.client != nil && c.client.IsConnected() {
-		c.client.Disconnect(1000) // 1 second timeout
+	// A client in its reconnect loop is stopped as well
+	if client != nil {
+		client.Disconnect(1000) // 1 second timeout
+		metrics.MQTTConnected.Set(0)
 		c.log.Info("MQTT client disconnected")
 	}
-	c.mu.Unlock()
 }
 
 // Publish sends a message to the specified topic.
@@ -153,8 +343,17 @@ func (c *PahoClient) Publish(ctx context.Context, topic string, payload []byte,
 
 	c.mu.RLock()
 	client := c.client
+	rebuilding := c.rebuilding
+	if !rebuilding {
+		c.inflight.Add(1)
+	}
 	c.mu.RUnlock()
 
+	if rebuilding {
+		return fmt.Errorf("MQTT client not connected")
+	}
+	defer c.inflight.Done()
+
 	token := client.Publish(topic, qos, retain, payload)
 
 	select {
@@ -177,6 +376,7 @@ func (c *PahoClient) WaitForConnection(ctx context.Context) error {
 	c.mu.RLock()
 	client := c.client
 	disconnecting := c.disconnecting
+	rebuilding := c.rebuilding
 	c.mu.RUnlock()
 
 	if client == nil {
@@ -188,12 +388,15 @@ func (c *PahoClient) WaitForConnection(ctx context.Context) error {
 	}
 
 	// Already connected - fast path
-	if client.IsConnected() {
+	if !rebuilding && client.IsConnected() {
 		return nil
 	}
 
 	c.log.Info("Waiting for MQTT reconnection before publish")
 
+	start := time.Now()
+	defer func() { metrics.MQTTConnectionWait.Observe(time.Since(start).Seconds()) }()
+
 	// Poll for reconnection with timeout
 	ticker := time.NewTicker(500 * time.Millisecond)
 	defer ticker.Stop()
@@ -211,7 +414,7 @@ func (c *PahoClient) WaitForConnection(ctx context.Context) error {
 
 		case <-ticker.C:
 			c.mu.RLock()
-			connected := c.client != nil && c.client.IsConnected()
+			connected := !c.rebuilding && c.client != nil && c.client.IsConnected()
 			disconnecting := c.disconnecting
 			c.mu.RUnlock()
 
@@ -228,6 +431,7 @@ func (c *PahoClient) WaitForConnection(ctx context.Context) error {
 }
 
 // Subscribe subscribes to a topic with the given QoS and message handler.
+// The subscription is restored automatically after a reconnect.
 func (c *PahoClient) Subscribe(ctx context.Context, topic string, qos byte, handler MessageHandler) error {
 	if err := c.WaitForConnection(ctx); err != nil {
 		return err
@@ -237,18 +441,27 @@ func (c *PahoClient) Subscribe(ctx context.Context, topic string, qos byte, hand
 	client := c.client
 	c.mu.RUnlock()
 
-	token := client.Subscribe(topic, qos, func(_ pahomqtt.Client, msg pahomqtt.Message) {
-		handler(msg.Topic(), msg.Payload())
-	})
+	c.subMu.Lock()
+	c.subscriptions[topic] = pahoSubscription{qos: qos, handler: handler}
+	c.subMu.Unlock()
+
+	token := client.Subscribe(topic, qos, pahoHandler(handler))
 
+	var err error
 	select {
 	case <-ctx.Done():
-		return ctx.Err()
+		err = ctx.Err()
 	case <-token.Done():
 		if token.Error() != nil {
-			return fmt.Errorf("failed to subscribe to %s: %w", topic, token.Error())
+			err = fmt.Errorf("failed to subscribe to %s: %w", topic, token.Error())
 		}
 	}
+	if err != nil {
+		c.subMu.Lock()
+		delete(c.subscriptions, topic)
+		c.subMu.Unlock()
+		return err
+	}
 
 	c.log.V(1).Info("Subscribed to MQTT topic", "topic", topic, "qos", qos)
 	return nil
@@ -264,6 +477,12 @@ func (c *PahoClient) Unsubscribe(ctx context.Context, topics ...string) error {
 		return fmt.Errorf("MQTT client not initialized")
 	}
 
+	c.subMu.Lock()
+	for _, t := range topics {
+		delete(c.subscriptions, t)
+	}
+	c.subMu.Unlock()
+
 	token := client.Unsubscribe(topics...)
 
 	select {
@@ -279,12 +498,30 @@ func (c *PahoClient) Unsubscribe(ctx context.Context, topics ...string) error {
 	return nil
 }
 
-// IsConnected returns whether the client is connected.
+// IsConnected returns whether the client is connected. It is false while
+// UpdateCredentials rebuilds the client.
 func (c *PahoClient) IsConnected() bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	return c.client != nil && c.client.IsConnected()
+	return !c.rebuilding && c.client != nil && c.client.IsConnected()
+}
+
+// waitTimeout waits for wg to reach zero and reports whether it did so
+// before the timeout elapsed.
+func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
 }
 
 // MockClient is a mock MQTT client for testing.
@@ -387,6 +624,12 @@ func (m *MockClient) WaitForConnection(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockClient) LastError() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.connectErr
+}
+
 // GetPublishedMessages returns all published messages for testing.
 func (m *MockClient) GetPublishedMessages() []PublishedMessage {
 	m.mu.Lock()
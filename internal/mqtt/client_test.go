@@ -0,0 +1,149 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pahomqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/go-logr/logr"
+)
+
+// reconnectingPahoClient is a paho client that lost its connection and is
+// retrying in the background, e.g. after the broker rejected its password.
+type reconnectingPahoClient struct {
+	pahomqtt.Client
+	disconnects atomic.Int32
+}
+
+func (c *reconnectingPahoClient) IsConnected() bool       { return false }
+func (c *reconnectingPahoClient) IsConnectionOpen() bool  { return false }
+func (c *reconnectingPahoClient) Disconnect(quiesce uint) { c.disconnects.Add(1) }
+
+func TestPahoClient_UpdateCredentialsStopsReconnectingClient(t *testing.T) {
+	// A broker that closes every connection, so the new client cannot connect either
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	old := &reconnectingPahoClient{}
+	c := NewClient(&Config{
+		Broker:   "127.0.0.1",
+		Port:     ln.Addr().(*net.TCPAddr).Port,
+		ClientID: "test",
+		Username: "user",
+		Password: "old",
+	}, logr.Discard())
+	c.client = old
+	defer c.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := c.UpdateCredentials(ctx, "user", "new"); err == nil {
+		t.Error("UpdateCredentials() succeeded against a broker closing every connection")
+	}
+	if got := old.disconnects.Load(); got != 1 {
+		t.Errorf("old client disconnected %d times, want 1", got)
+	}
+	if c.client == old {
+		t.Error("old client was not replaced")
+	}
+	if c.config.Password != "new" {
+		t.Errorf("password = %q, want the new one", c.config.Password)
+	}
+}
+
+func TestPahoClient_UpdateCredentialsDoesNotBlock(t *testing.T) {
+	// A broker that accepts connections and never answers CONNECT
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	c := NewClient(&Config{
+		Broker:   "127.0.0.1",
+		Port:     ln.Addr().(*net.TCPAddr).Port,
+		ClientID: "test",
+		Username: "user",
+		Password: "old",
+	}, logr.Discard())
+	c.client = &reconnectingPahoClient{}
+	defer c.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	rotated := make(chan error, 1)
+	go func() { rotated <- c.UpdateCredentials(ctx, "user", "new") }()
+
+	// Wait until the rebuild has started
+	deadline := time.Now().Add(time.Second)
+	for {
+		c.mu.RLock()
+		rebuilding := c.rebuilding
+		c.mu.RUnlock()
+		if rebuilding {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("UpdateCredentials() did not start the rebuild")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	state := make(chan bool, 1)
+	go func() {
+		_ = c.LastError()
+		state <- c.IsConnected()
+	}()
+	select {
+	case connected := <-state:
+		if connected {
+			t.Error("IsConnected() = true while rebuilding")
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("IsConnected() blocked during the rebuild")
+	}
+
+	if err := <-rotated; err == nil {
+		t.Error("UpdateCredentials() succeeded against a stalled broker, want error")
+	}
+}
@@ -20,6 +20,13 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
+)
+
+// MQTT protocol versions, as sent in the CONNECT protocol level byte.
+const (
+	ProtocolVersion311 = 4
+	ProtocolVersion5   = 5
 )
 
 // Config holds the MQTT connection configuration.
@@ -30,6 +37,38 @@ type Config struct {
 	Username string
 	Password string
 	UseTLS   bool
+
+	// CredentialsDir is a directory (typically a mounted Secret) holding the
+	// username and password. When set, its files take the place of the
+	// MQTT_USERNAME and MQTT_PASSWORD variables and are watched for rotation.
+	CredentialsDir string
+
+	// CredentialsPollInterval is how often CredentialsDir is checked for changes.
+	CredentialsPollInterval time.Duration
+
+	// ProtocolVersion selects the MQTT protocol: ProtocolVersion311 (default)
+	// or ProtocolVersion5.
+	ProtocolVersion int
+
+	// SessionExpiry asks an MQTT v5 broker to keep the session for this long
+	// after a disconnect. Zero starts a clean session on every connect.
+	SessionExpiry time.Duration
+
+	// PublishRate limits how many messages per second the outbox sends.
+	// Zero or less disables the limit.
+	PublishRate float64
+
+	// PublishBurst is the number of messages the outbox may send at once
+	// before PublishRate applies.
+	PublishBurst int
+
+	// ReadyGracePeriod is how long the broker may be unreachable before the
+	// readiness check fails.
+	ReadyGracePeriod time.Duration
+
+	// OutboxFile, if set, is where the outbox persists pending deletions so
+	// that they survive a restart.
+	OutboxFile string
 }
 
 // NewConfigFromEnv creates a Config from environment variables.
@@ -59,14 +98,80 @@ func NewConfigFromEnv() (*Config, error) {
 		useTLS = true
 	}
 
-	return &Config{
-		Broker:   broker,
-		Port:     port,
-		ClientID: clientID,
-		Username: os.Getenv("MQTT_USERNAME"),
-		Password: os.Getenv("MQTT_PASSWORD"),
-		UseTLS:   useTLS,
-	}, nil
+	cfg := &Config{
+		Broker:                  broker,
+		Port:                    port,
+		ClientID:                clientID,
+		Username:                os.Getenv("MQTT_USERNAME"),
+		Password:                os.Getenv("MQTT_PASSWORD"),
+		UseTLS:                  useTLS,
+		CredentialsDir:          os.Getenv("MQTT_CREDENTIALS_DIR"),
+		CredentialsPollInterval: DefaultCredentialsPollInterval,
+		ProtocolVersion:         ProtocolVersion311,
+		PublishRate:             DefaultPublishRate,
+		PublishBurst:            DefaultPublishBurst,
+		OutboxFile:              os.Getenv("MQTT_OUTBOX_FILE"),
+		ReadyGracePeriod:        DefaultReadyGracePeriod,
+	}
+
+	if v := os.Getenv("MQTT_READY_GRACE_PERIOD"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MQTT_READY_GRACE_PERIOD: %w", err)
+		}
+		cfg.ReadyGracePeriod = d
+	}
+
+	if v := os.Getenv("MQTT_PUBLISH_RATE"); v != "" {
+		r, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MQTT_PUBLISH_RATE: %w", err)
+		}
+		cfg.PublishRate = r
+	}
+
+	if v := os.Getenv("MQTT_PUBLISH_BURST"); v != "" {
+		b, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MQTT_PUBLISH_BURST: %w", err)
+		}
+		cfg.PublishBurst = b
+	}
+
+	switch v := os.Getenv("MQTT_PROTOCOL_VERSION"); v {
+	case "", "3.1.1", "4":
+	case "5", "5.0":
+		cfg.ProtocolVersion = ProtocolVersion5
+	default:
+		return nil, fmt.Errorf("invalid MQTT_PROTOCOL_VERSION %q: must be 3.1.1 or 5", v)
+	}
+
+	if v := os.Getenv("MQTT_SESSION_EXPIRY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MQTT_SESSION_EXPIRY: %w", err)
+		}
+		cfg.SessionExpiry = d
+	}
+
+	if v := os.Getenv("MQTT_CREDENTIALS_POLL_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MQTT_CREDENTIALS_POLL_INTERVAL: %w", err)
+		}
+		cfg.CredentialsPollInterval = d
+	}
+
+	if cfg.CredentialsDir != "" {
+		username, password, err := ReadCredentialsDir(cfg.CredentialsDir)
+		if err != nil {
+			return nil, fmt.Errorf("reading MQTT_CREDENTIALS_DIR: %w", err)
+		}
+		cfg.Username = username
+		cfg.Password = password
+	}
+
+	return cfg, nil
 }
 
 // BrokerURL returns the full broker URL.
@@ -0,0 +1,156 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// DefaultCredentialsPollInterval is how often the credentials directory is checked for changes.
+const DefaultCredentialsPollInterval = 10 * time.Second
+
+// Credential file names looked up in the credentials directory, in order of preference.
+// The upper-case names match the keys of the mqtt-config Secret so that the same
+// Secret can be used both with envFrom and as a volume.
+var (
+	usernameFiles = []string{"MQTT_USERNAME", "username"}
+	passwordFiles = []string{"MQTT_PASSWORD", "password"}
+)
+
+// ReadCredentialsDir reads the MQTT username and password from files in dir.
+// Missing files yield empty values; trailing newlines are stripped.
+func ReadCredentialsDir(dir string) (username, password string, err error) {
+	username, err = readFirstFile(dir, usernameFiles)
+	if err != nil {
+		return "", "", err
+	}
+	password, err = readFirstFile(dir, passwordFiles)
+	if err != nil {
+		return "", "", err
+	}
+	return username, password, nil
+}
+
+// readFirstFile returns the contents of the first file in names that exists in dir.
+func readFirstFile(dir string, names []string) (string, error) {
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("reading %s: %w", name, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return "", nil
+}
+
+// CredentialsUpdater is implemented by clients that can swap credentials at runtime.
+type CredentialsUpdater interface {
+	UpdateCredentials(ctx context.Context, username, password string) error
+}
+
+// CredentialsWatcher polls a mounted Secret directory and pushes changed
+// credentials to the MQTT client. Kubelet updates mounted Secrets in place
+// when they change, so rotating the Secret is enough to reconnect.
+type CredentialsWatcher struct {
+	dir      string
+	interval time.Duration
+	client   CredentialsUpdater
+	log      logr.Logger
+
+	username string
+	password string
+}
+
+// NewCredentialsWatcher creates a CredentialsWatcher for config.CredentialsDir.
+// The watcher starts from the credentials already present in config.
+func NewCredentialsWatcher(config *Config, client CredentialsUpdater, log logr.Logger) *CredentialsWatcher {
+	interval := config.CredentialsPollInterval
+	if interval <= 0 {
+		interval = DefaultCredentialsPollInterval
+	}
+	return &CredentialsWatcher{
+		dir:      config.CredentialsDir,
+		interval: interval,
+		client:   client,
+		log:      log.WithName("mqtt-credentials"),
+		username: config.Username,
+		password: config.Password,
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica
+// holds its own broker connection, so every replica must reload credentials.
+func (w *CredentialsWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable. It polls the credentials directory until ctx is done.
+func (w *CredentialsWatcher) Start(ctx context.Context) error {
+	w.log.Info("Watching MQTT credentials", "dir", w.dir, "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.check(ctx)
+		}
+	}
+}
+
+// check reloads credentials from disk and updates the client if they changed.
+func (w *CredentialsWatcher) check(ctx context.Context) {
+	username, password, err := ReadCredentialsDir(w.dir)
+	if err != nil {
+		w.log.Error(err, "Failed to read MQTT credentials", "dir", w.dir)
+		return
+	}
+
+	if username == w.username && password == w.password {
+		return
+	}
+
+	// Record the new values before updating: a failed update keeps retrying
+	// with them in the background, so there is no point rebuilding again
+	// until the files change once more.
+	w.username = username
+	w.password = password
+
+	updateCtx, cancel := context.WithTimeout(ctx, DefaultConnectTimeout)
+	defer cancel()
+	if err := w.client.UpdateCredentials(updateCtx, username, password); err != nil {
+		w.log.Error(err, "Failed to apply rotated MQTT credentials")
+		return
+	}
+
+	w.log.Info("Applied rotated MQTT credentials")
+}
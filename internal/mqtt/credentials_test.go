@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+)
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+		t.Fatalf("writing %s: %v", name, err)
+	}
+}
+
+func TestReadCredentialsDir(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        map[string]string
+		wantUsername string
+		wantPassword string
+	}{
+		{
+			name:         "secret key names",
+			files:        map[string]string{"MQTT_USERNAME": "ha", "MQTT_PASSWORD": "secret"},
+			wantUsername: "ha",
+			wantPassword: "secret",
+		},
+		{
+			name:         "lowercase names",
+			files:        map[string]string{"username": "ha", "password": "secret"},
+			wantUsername: "ha",
+			wantPassword: "secret",
+		},
+		{
+			name:         "secret key names take precedence",
+			files:        map[string]string{"MQTT_USERNAME": "new", "username": "old"},
+			wantUsername: "new",
+		},
+		{
+			name:         "trailing newline stripped",
+			files:        map[string]string{"username": "ha\n", "password": "secret\r\n"},
+			wantUsername: "ha",
+			wantPassword: "secret",
+		},
+		{
+			name:  "empty directory",
+			files: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				writeFile(t, dir, name, content)
+			}
+
+			username, password, err := ReadCredentialsDir(dir)
+			if err != nil {
+				t.Fatalf("ReadCredentialsDir() error: %v", err)
+			}
+			if username != tt.wantUsername {
+				t.Errorf("username = %q, want %q", username, tt.wantUsername)
+			}
+			if password != tt.wantPassword {
+				t.Errorf("password = %q, want %q", password, tt.wantPassword)
+			}
+		})
+	}
+}
+
+type fakeCredentialsUpdater struct {
+	calls [][2]string
+	err   error
+}
+
+func (f *fakeCredentialsUpdater) UpdateCredentials(_ context.Context, username, password string) error {
+	f.calls = append(f.calls, [2]string{username, password})
+	return f.err
+}
+
+func TestCredentialsWatcher_Check(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "username", "ha")
+	writeFile(t, dir, "password", "one")
+
+	updater := &fakeCredentialsUpdater{}
+	w := NewCredentialsWatcher(&Config{CredentialsDir: dir, Username: "ha", Password: "one"}, updater, logr.Discard())
+
+	// Unchanged credentials do not trigger an update
+	w.check(context.Background())
+	if len(updater.calls) != 0 {
+		t.Fatalf("expected no update, got %v", updater.calls)
+	}
+
+	// Rotated password triggers exactly one update
+	writeFile(t, dir, "password", "two")
+	w.check(context.Background())
+	w.check(context.Background())
+	if len(updater.calls) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(updater.calls))
+	}
+	if updater.calls[0] != [2]string{"ha", "two"} {
+		t.Errorf("update called with %v, want [ha two]", updater.calls[0])
+	}
+
+	// A failed update is not retried until the files change again
+	updater.err = fmt.Errorf("not authorized")
+	writeFile(t, dir, "password", "three")
+	w.check(context.Background())
+	w.check(context.Background())
+	if len(updater.calls) != 2 {
+		t.Errorf("expected 2 updates, got %d", len(updater.calls))
+	}
+}
+
+func TestNewConfigFromEnv_CredentialsDir(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "MQTT_USERNAME", "from-file")
+	writeFile(t, dir, "MQTT_PASSWORD", "file-secret")
+
+	t.Setenv("MQTT_BROKER", "broker.local")
+	t.Setenv("MQTT_USERNAME", "from-env")
+	t.Setenv("MQTT_PASSWORD", "env-secret")
+	t.Setenv("MQTT_CREDENTIALS_DIR", dir)
+	t.Setenv("MQTT_CREDENTIALS_POLL_INTERVAL", "1m")
+
+	cfg, err := NewConfigFromEnv()
+	if err != nil {
+		t.Fatalf("NewConfigFromEnv() error: %v", err)
+	}
+	if cfg.Username != "from-file" || cfg.Password != "file-secret" {
+		t.Errorf("credentials = %q/%q, want from-file/file-secret", cfg.Username, cfg.Password)
+	}
+	if cfg.CredentialsPollInterval.String() != "1m0s" {
+		t.Errorf("CredentialsPollInterval = %v, want 1m0s", cfg.CredentialsPollInterval)
+	}
+}
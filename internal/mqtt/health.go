@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultReadyGracePeriod is how long the broker may be unreachable before
+// the readiness check fails.
+const DefaultReadyGracePeriod = 30 * time.Second
+
+// ConnectionChecker is a readiness check backed by Client.IsConnected.
+// Short disconnects within the grace period are tolerated so that a broker
+// restart does not flap every replica out of its Service.
+type ConnectionChecker struct {
+	client Client
+	grace  time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	downSince time.Time
+}
+
+// NewConnectionChecker creates a ConnectionChecker for client.
+func NewConnectionChecker(client Client, grace time.Duration) *ConnectionChecker {
+	return &ConnectionChecker{
+		client: client,
+		grace:  grace,
+		now:    time.Now,
+	}
+}
+
+// Check implements healthz.Checker.
+func (c *ConnectionChecker) Check(_ *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client.IsConnected() {
+		c.downSince = time.Time{}
+		return nil
+	}
+
+	now := c.now()
+	if c.downSince.IsZero() {
+		c.downSince = now
+	}
+	down := now.Sub(c.downSince)
+	if down < c.grace {
+		return nil
+	}
+
+	if err := c.client.LastError(); err != nil {
+		return fmt.Errorf("MQTT broker unreachable for %s: %w", down.Round(time.Second), err)
+	}
+	return fmt.Errorf("MQTT broker unreachable for %s", down.Round(time.Second))
+}
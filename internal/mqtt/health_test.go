@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestConnectionChecker(t *testing.T) {
+	mock := NewMockClient()
+	_ = mock.Connect(context.Background())
+
+	now := time.Now()
+	c := NewConnectionChecker(mock, 30*time.Second)
+	c.now = func() time.Time { return now }
+
+	if err := c.Check(nil); err != nil {
+		t.Fatalf("Check() while connected = %v, want nil", err)
+	}
+
+	// Disconnects within the grace period are tolerated
+	mock.Disconnect()
+	if err := c.Check(nil); err != nil {
+		t.Errorf("Check() at start of outage = %v, want nil", err)
+	}
+	now = now.Add(20 * time.Second)
+	if err := c.Check(nil); err != nil {
+		t.Errorf("Check() within grace period = %v, want nil", err)
+	}
+
+	now = now.Add(20 * time.Second)
+	if err := c.Check(nil); err == nil {
+		t.Error("Check() after grace period = nil, want error")
+	}
+
+	// Reconnecting resets the grace period
+	_ = mock.Connect(context.Background())
+	if err := c.Check(nil); err != nil {
+		t.Errorf("Check() after reconnect = %v, want nil", err)
+	}
+	mock.Disconnect()
+	now = now.Add(time.Minute)
+	if err := c.Check(nil); err != nil {
+		t.Errorf("Check() at start of second outage = %v, want nil", err)
+	}
+}
@@ -0,0 +1,397 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+
+	"github.com/spontus/hass-crds/internal/metrics"
+)
+
+const (
+	// DefaultPublishRate is the default outbox drain rate in messages per second.
+	DefaultPublishRate = 20
+
+	// DefaultPublishBurst is the default number of messages sent back to back.
+	DefaultPublishBurst = 20
+
+	// outboxPollInterval is how often the outbox checks for a reconnect.
+	outboxPollInterval = time.Second
+
+	// outboxMaxRetryInterval caps the backoff after a failed delivery.
+	outboxMaxRetryInterval = time.Minute
+)
+
+// outboxEntry is a queued publish. Only the latest entry per topic is kept.
+type outboxEntry struct {
+	Topic          string         `json:"topic"`
+	Payload        []byte         `json:"payload,omitempty"`
+	QoS            byte           `json:"qos"`
+	Retain         bool           `json:"retain"`
+	UserProperties []UserProperty `json:"userProperties,omitempty"`
+	MessageExpiry  time.Duration  `json:"messageExpiry,omitempty"`
+}
+
+// context restores the per-publish options captured when the entry was queued.
+func (e *outboxEntry) context(ctx context.Context) context.Context {
+	if len(e.UserProperties) > 0 {
+		ctx = WithUserProperties(ctx, e.UserProperties...)
+	}
+	if e.MessageExpiry > 0 {
+		ctx = WithMessageExpiry(ctx, e.MessageExpiry)
+	}
+	return ctx
+}
+
+// Outbox is a Client that queues publishes while the broker is unreachable
+// instead of blocking the caller. Queued publishes are coalesced per topic,
+// keeping only the latest payload, and drained in order at a limited rate
+// once the connection is back. Publishing while connected with an empty queue
+// goes straight to the underlying client so broker errors still reach the caller.
+//
+// Pending deletions (empty payloads) can be persisted to a file so they are
+// not lost when the controller restarts before the broker comes back.
+type Outbox struct {
+	Client
+
+	limiter *rate.Limiter
+	file    string
+	log     logr.Logger
+
+	mu      sync.Mutex
+	order   []string
+	entries map[string]*outboxEntry
+	retryAt time.Time
+	backoff time.Duration
+
+	wake chan struct{}
+}
+
+// NewOutbox wraps client with a publish outbox configured from config.
+// Deletions persisted to config.OutboxFile by a previous run are queued again.
+func NewOutbox(client Client, config *Config, log logr.Logger) (*Outbox, error) {
+	limit := rate.Inf
+	if config.PublishRate > 0 {
+		limit = rate.Limit(config.PublishRate)
+	}
+	burst := config.PublishBurst
+	if burst < 1 {
+		burst = 1
+	}
+
+	o := &Outbox{
+		Client:  client,
+		limiter: rate.NewLimiter(limit, burst),
+		file:    config.OutboxFile,
+		log:     log.WithName("mqtt-outbox"),
+		entries: make(map[string]*outboxEntry),
+		backoff: outboxPollInterval,
+		wake:    make(chan struct{}, 1),
+	}
+
+	if err := o.load(); err != nil {
+		return nil, fmt.Errorf("loading outbox from %s: %w", o.file, err)
+	}
+	return o, nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Publishes are
+// queued by whichever component made them, so every replica drains its own outbox.
+func (o *Outbox) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable. It drains the outbox until ctx is done.
+func (o *Outbox) Start(ctx context.Context) error {
+	o.log.Info("Starting MQTT publish outbox", "rate", o.limiter.Limit(), "burst", o.limiter.Burst(), "depth", o.Depth())
+
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if depth := o.Depth(); depth > 0 {
+				o.log.Info("Stopping MQTT publish outbox with undelivered messages", "depth", depth)
+			}
+			return nil
+		case <-o.wake:
+		case <-ticker.C:
+		}
+
+		o.drain(ctx)
+	}
+}
+
+// Publish sends the message directly when connected and nothing is queued,
+// and otherwise queues it, replacing any queued message for the same topic.
+// A queued publish returns nil; use Pending to tell it apart from a delivered one.
+func (o *Outbox) Publish(ctx context.Context, topic string, payload []byte, qos byte, retain bool) error {
+	if o.Depth() == 0 && o.Client.IsConnected() {
+		if err := o.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		err := o.Client.Publish(ctx, topic, payload, qos, retain)
+		if err == nil || o.Client.IsConnected() {
+			return err
+		}
+		// The connection dropped mid-publish: queue the message instead
+		o.log.V(1).Info("Connection lost during publish, queueing", "topic", topic, "error", err.Error())
+	}
+
+	opts := publishOptionsFrom(ctx)
+	o.enqueue(&outboxEntry{
+		Topic:          topic,
+		Payload:        payload,
+		QoS:            qos,
+		Retain:         retain,
+		UserProperties: opts.userProperties,
+		MessageExpiry:  opts.messageExpiry,
+	})
+	return nil
+}
+
+// Depth returns the number of queued messages.
+func (o *Outbox) Depth() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.order)
+}
+
+// Pending reports whether a message for topic is queued and not yet delivered.
+func (o *Outbox) Pending(topic string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, ok := o.entries[topic]
+	return ok
+}
+
+// enqueue adds entry to the queue. A queued message for the same topic is
+// replaced in place, keeping its position.
+func (o *Outbox) enqueue(entry *outboxEntry) {
+	o.mu.Lock()
+	if _, ok := o.entries[entry.Topic]; !ok {
+		o.order = append(o.order, entry.Topic)
+	}
+	o.entries[entry.Topic] = entry
+	depth := len(o.order)
+	metrics.OutboxDepth.Set(float64(depth))
+	o.persistLocked()
+	o.mu.Unlock()
+
+	o.log.V(1).Info("Queued MQTT message", "topic", entry.Topic, "depth", depth)
+
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// drain publishes queued messages while connected. A message the broker
+// refuses with a permanent reason code is dropped. After any other failed
+// delivery the message moves to the end of the queue, so it does not hold back
+// the others, and drain backs off exponentially before trying again.
+func (o *Outbox) drain(ctx context.Context) {
+	o.mu.Lock()
+	wait := time.Until(o.retryAt)
+	o.mu.Unlock()
+	if wait > 0 {
+		return
+	}
+
+	delivered := 0
+	for o.Client.IsConnected() {
+		entry := o.head()
+		if entry == nil {
+			break
+		}
+		if err := o.limiter.Wait(ctx); err != nil {
+			return
+		}
+
+		pubCtx, cancel := context.WithTimeout(entry.context(ctx), DefaultWriteTimeout)
+		err := o.Client.Publish(pubCtx, entry.Topic, entry.Payload, entry.QoS, entry.Retain)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			var rcErr *ReasonCodeError
+			if errors.As(err, &rcErr) && rcErr.Code.IsPermanent() {
+				o.remove(entry)
+				metrics.OutboxDroppedTotal.Inc()
+				o.log.Error(err, "Dropping queued MQTT message refused by the broker", "topic", entry.Topic)
+				continue
+			}
+			o.requeue(entry)
+			o.mu.Lock()
+			o.retryAt = time.Now().Add(o.backoff)
+			retryIn := o.backoff
+			o.backoff = min(o.backoff*2, outboxMaxRetryInterval)
+			depth := len(o.order)
+			o.mu.Unlock()
+
+			o.log.Error(err, "Failed to deliver queued MQTT message, will retry", "topic", entry.Topic, "retryIn", retryIn, "depth", depth)
+			return
+		}
+
+		o.remove(entry)
+		delivered++
+	}
+
+	if delivered > 0 {
+		o.mu.Lock()
+		o.backoff = outboxPollInterval
+		depth := len(o.order)
+		o.mu.Unlock()
+		o.log.Info("Delivered queued MQTT messages", "count", delivered, "depth", depth)
+	}
+}
+
+// head returns the oldest queued entry, or nil if the queue is empty.
+func (o *Outbox) head() *outboxEntry {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.order) == 0 {
+		return nil
+	}
+	return o.entries[o.order[0]]
+}
+
+// requeue moves entry to the end of the queue after a failed delivery, unless
+// it was replaced by a newer message for the same topic in the meantime.
+func (o *Outbox) requeue(entry *outboxEntry) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.entries[entry.Topic] != entry {
+		return
+	}
+	for i, t := range o.order {
+		if t == entry.Topic {
+			o.order = append(append(o.order[:i], o.order[i+1:]...), t)
+			break
+		}
+	}
+	o.persistLocked()
+}
+
+// remove drops entry after delivery, unless it was replaced by a newer
+// message for the same topic in the meantime.
+func (o *Outbox) remove(entry *outboxEntry) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.entries[entry.Topic] != entry {
+		return
+	}
+	delete(o.entries, entry.Topic)
+	for i, t := range o.order {
+		if t == entry.Topic {
+			o.order = append(o.order[:i], o.order[i+1:]...)
+			break
+		}
+	}
+	metrics.OutboxDepth.Set(float64(len(o.order)))
+	o.persistLocked()
+}
+
+// persistLocked writes queued deletions to the outbox file. Discovery configs
+// are republished by reconciliation after a restart, but a deletion whose
+// resource is already gone would otherwise be lost. The caller must hold o.mu.
+func (o *Outbox) persistLocked() {
+	if o.file == "" {
+		return
+	}
+
+	deletions := []*outboxEntry{}
+	for _, t := range o.order {
+		if e := o.entries[t]; len(e.Payload) == 0 {
+			deletions = append(deletions, e)
+		}
+	}
+
+	if err := writeFileAtomic(o.file, deletions); err != nil {
+		o.log.Error(err, "Failed to persist outbox", "file", o.file)
+	}
+}
+
+// load queues the deletions persisted by a previous run.
+func (o *Outbox) load() error {
+	if o.file == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(o.file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var entries []*outboxEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if _, ok := o.entries[e.Topic]; !ok {
+			o.order = append(o.order, e.Topic)
+		}
+		o.entries[e.Topic] = e
+	}
+
+	metrics.OutboxDepth.Set(float64(len(o.order)))
+	if len(entries) > 0 {
+		o.log.Info("Restored queued MQTT deletions", "file", o.file, "count", len(entries))
+	}
+	return nil
+}
+
+// writeFileAtomic writes v as JSON to path via a temporary file and rename.
+func writeFileAtomic(path string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/spontus/hass-crds/internal/metrics"
+)
+
+func newTestOutbox(t *testing.T, client Client, file string) *Outbox {
+	t.Helper()
+	o, err := NewOutbox(client, &Config{OutboxFile: file}, logr.Discard())
+	if err != nil {
+		t.Fatalf("NewOutbox() error: %v", err)
+	}
+	return o
+}
+
+func TestOutbox_PublishConnected(t *testing.T) {
+	mock := NewMockClient()
+	_ = mock.Connect(context.Background())
+	o := newTestOutbox(t, mock, "")
+
+	if err := o.Publish(context.Background(), "a", []byte("1"), 1, true); err != nil {
+		t.Fatalf("Publish() error: %v", err)
+	}
+	if got := len(mock.GetPublishedMessages()); got != 1 {
+		t.Errorf("published %d messages, want 1", got)
+	}
+	if o.Depth() != 0 {
+		t.Errorf("Depth() = %d, want 0", o.Depth())
+	}
+
+	// Broker errors on a live connection are returned, not queued
+	mock.SetPublishError(fmt.Errorf("not authorized"))
+	if err := o.Publish(context.Background(), "a", []byte("2"), 1, true); err == nil {
+		t.Error("Publish() succeeded, want error")
+	}
+	if o.Depth() != 0 {
+		t.Errorf("Depth() = %d, want 0", o.Depth())
+	}
+}
+
+func TestOutbox_CoalesceAndDrain(t *testing.T) {
+	mock := NewMockClient()
+	o := newTestOutbox(t, mock, "")
+
+	publishes := []struct {
+		topic   string
+		payload string
+	}{
+		{"a", "1"},
+		{"b", "1"},
+		{"a", "2"},
+		{"c", ""},
+		{"a", "3"},
+	}
+	for _, p := range publishes {
+		if err := o.Publish(context.Background(), p.topic, []byte(p.payload), 1, true); err != nil {
+			t.Fatalf("Publish(%s) error: %v", p.topic, err)
+		}
+	}
+
+	if o.Depth() != 3 {
+		t.Fatalf("Depth() = %d, want 3", o.Depth())
+	}
+	if got := testutil.ToFloat64(metrics.OutboxDepth); got != 3 {
+		t.Errorf("outbox depth metric = %v, want 3", got)
+	}
+	if !o.Pending("a") || o.Pending("d") {
+		t.Errorf("Pending(a) = %v, Pending(d) = %v, want true, false", o.Pending("a"), o.Pending("d"))
+	}
+
+	// Nothing is delivered while disconnected
+	o.drain(context.Background())
+	if got := len(mock.GetPublishedMessages()); got != 0 {
+		t.Fatalf("published %d messages while disconnected, want 0", got)
+	}
+
+	_ = mock.Connect(context.Background())
+	o.drain(context.Background())
+
+	msgs := mock.GetPublishedMessages()
+	want := []struct{ topic, payload string }{{"a", "3"}, {"b", "1"}, {"c", ""}}
+	if len(msgs) != len(want) {
+		t.Fatalf("published %d messages, want %d", len(msgs), len(want))
+	}
+	for i, w := range want {
+		if msgs[i].Topic != w.topic || string(msgs[i].Payload) != w.payload {
+			t.Errorf("message %d = %s=%q, want %s=%q", i, msgs[i].Topic, msgs[i].Payload, w.topic, w.payload)
+		}
+	}
+	if o.Depth() != 0 {
+		t.Errorf("Depth() = %d after drain, want 0", o.Depth())
+	}
+}
+
+func TestOutbox_DrainFailureKeepsMessage(t *testing.T) {
+	mock := NewMockClient()
+	o := newTestOutbox(t, mock, "")
+
+	_ = o.Publish(context.Background(), "a", []byte("1"), 1, true)
+
+	_ = mock.Connect(context.Background())
+	mock.SetPublishError(fmt.Errorf("broker busy"))
+	o.drain(context.Background())
+
+	if !o.Pending("a") {
+		t.Fatal("message dropped after failed delivery")
+	}
+
+	// Retries wait for the backoff to expire
+	mock.SetPublishError(nil)
+	o.drain(context.Background())
+	if !o.Pending("a") {
+		t.Error("message delivered before backoff expired")
+	}
+}
+
+func TestOutbox_PersistsDeletions(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "outbox.json")
+
+	mock := NewMockClient()
+	o := newTestOutbox(t, mock, file)
+	_ = o.Publish(WithUserProperties(context.Background(), UserProperty{Key: "cr-uid", Value: "1"}), "deleted", nil, 1, true)
+	_ = o.Publish(context.Background(), "config", []byte("{}"), 1, true)
+
+	// A new outbox, as after a restart, restores only the deletion
+	restored := newTestOutbox(t, mock, file)
+	if restored.Depth() != 1 || !restored.Pending("deleted") {
+		t.Fatalf("restored Depth() = %d, Pending(deleted) = %v, want 1, true", restored.Depth(), restored.Pending("deleted"))
+	}
+	if props := restored.head().UserProperties; len(props) != 1 || props[0].Value != "1" {
+		t.Errorf("restored user properties = %v, want [cr-uid=1]", props)
+	}
+
+	_ = mock.Connect(context.Background())
+	restored.drain(context.Background())
+
+	// Delivered deletions are removed from the file
+	again := newTestOutbox(t, mock, file)
+	if again.Depth() != 0 {
+		t.Errorf("Depth() after delivery and restart = %d, want 0", again.Depth())
+	}
+}
+
+// topicFailingClient fails every publish to one topic with err.
+type topicFailingClient struct {
+	*MockClient
+	topic string
+	err   error
+}
+
+func (c *topicFailingClient) Publish(ctx context.Context, topic string, payload []byte, qos byte, retain bool) error {
+	if topic == c.topic {
+		return c.err
+	}
+	return c.MockClient.Publish(ctx, topic, payload, qos, retain)
+}
+
+func TestOutbox_FailingMessageDoesNotBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		dropped bool
+	}{
+		{
+			name:    "permanent reason code",
+			err:     &ReasonCodeError{Packet: "PUBACK", Code: ReasonNotAuthorized},
+			dropped: true,
+		},
+		{
+			name: "other error",
+			err:  fmt.Errorf("broker busy"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := NewMockClient()
+			client := &topicFailingClient{MockClient: mock, topic: "a", err: tt.err}
+			o := newTestOutbox(t, client, "")
+
+			_ = o.Publish(context.Background(), "a", []byte("1"), 1, true)
+			_ = o.Publish(context.Background(), "b", []byte("1"), 1, true)
+
+			dropped := testutil.ToFloat64(metrics.OutboxDroppedTotal)
+			_ = mock.Connect(context.Background())
+			o.drain(context.Background())
+
+			// A failed delivery backs off; the next drain gets past it
+			o.mu.Lock()
+			o.retryAt = time.Time{}
+			o.mu.Unlock()
+			o.drain(context.Background())
+
+			msgs := mock.GetPublishedMessages()
+			if len(msgs) != 1 || msgs[0].Topic != "b" {
+				t.Fatalf("published %v, want b behind the failing message", msgs)
+			}
+			if o.Pending("a") == tt.dropped {
+				t.Errorf("Pending(a) = %v, want %v", o.Pending("a"), !tt.dropped)
+			}
+			want := dropped
+			if tt.dropped {
+				want++
+			}
+			if got := testutil.ToFloat64(metrics.OutboxDroppedTotal); got != want {
+				t.Errorf("dropped metric = %v, want %v", got, want)
+			}
+		})
+	}
+}
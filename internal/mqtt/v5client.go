@@ -0,0 +1,732 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	"github.com/go-logr/logr"
+
+	"github.com/spontus/hass-crds/internal/metrics"
+)
+
+// publishOptionsKey is the context key for per-publish MQTT v5 options.
+type publishOptionsKey struct{}
+
+// publishOptions are per-publish MQTT v5 options carried on the context.
+type publishOptions struct {
+	userProperties []UserProperty
+	messageExpiry  time.Duration
+}
+
+func publishOptionsFrom(ctx context.Context) publishOptions {
+	if opts, ok := ctx.Value(publishOptionsKey{}).(publishOptions); ok {
+		return opts
+	}
+	return publishOptions{}
+}
+
+// WithUserProperties returns a context that tags publishes made with it with
+// the given MQTT v5 user properties. MQTT 3.1.1 clients ignore them.
+func WithUserProperties(ctx context.Context, props ...UserProperty) context.Context {
+	opts := publishOptionsFrom(ctx)
+	opts.userProperties = append(append([]UserProperty{}, opts.userProperties...), props...)
+	return context.WithValue(ctx, publishOptionsKey{}, opts)
+}
+
+// WithMessageExpiry returns a context that sets the MQTT v5 message expiry
+// interval on publishes made with it. MQTT 3.1.1 clients ignore it.
+func WithMessageExpiry(ctx context.Context, expiry time.Duration) context.Context {
+	opts := publishOptionsFrom(ctx)
+	opts.messageExpiry = expiry
+	return context.WithValue(ctx, publishOptionsKey{}, opts)
+}
+
+// v5Subscription is an active subscription restored after reconnecting.
+type v5Subscription struct {
+	qos     byte
+	handler MessageHandler
+}
+
+// v5Message is a received message waiting to be passed to the handlers.
+type v5Message struct {
+	topic   string
+	payload []byte
+}
+
+// v5Connection is one autopaho connection manager. Credential rotation
+// replaces it; callbacks from a replaced connection are ignored.
+type v5Connection struct {
+	cm     *autopaho.ConnectionManager
+	broker string
+	// first receives the outcome of the first connection attempt.
+	first chan error
+	// up is set once the connection has been established.
+	up bool
+}
+
+// V5Client is an MQTT v5 client built on paho.golang's autopaho. Unlike
+// PahoClient it surfaces broker reason codes as *ReasonCodeError, sends user
+// properties from WithUserProperties and supports session expiry.
+type V5Client struct {
+	config *Config
+	log    logr.Logger
+
+	// lifecycleMu serializes Connect, UpdateCredentials and Disconnect.
+	// It is never taken by Publish or by autopaho callbacks.
+	lifecycleMu sync.Mutex
+
+	// mu guards the fields below. It is never held while connecting.
+	mu            sync.RWMutex
+	conn          *v5Connection
+	connected     bool
+	disconnecting bool
+	lastErr       error
+	maxQoS        byte
+	noRetain      bool
+	dispatchStop  chan struct{}
+
+	// inflight tracks publishes on the current connection; see PahoClient.inflight.
+	inflight sync.WaitGroup
+
+	subsMu        sync.RWMutex
+	subscriptions map[string]v5Subscription
+
+	// Received messages are queued and passed to handlers by dispatchLoop,
+	// so a handler may publish and wait for PUBACK without blocking the
+	// goroutine that reads from the broker.
+	queueMu    sync.Mutex
+	queue      []v5Message
+	queueReady chan struct{}
+}
+
+// NewV5Client creates a new MQTT v5 client with the given configuration.
+func NewV5Client(config *Config, log logr.Logger) *V5Client {
+	return &V5Client{
+		config:        config,
+		log:           log.WithName("mqtt-client"),
+		subscriptions: make(map[string]v5Subscription),
+		queueReady:    make(chan struct{}, 1),
+	}
+}
+
+// Connect establishes the MQTT connection.
+func (c *V5Client) Connect(ctx context.Context) error {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
+	c.mu.Lock()
+	c.disconnecting = false
+	if c.dispatchStop == nil {
+		c.dispatchStop = make(chan struct{})
+		go c.dispatchLoop(c.dispatchStop)
+	}
+	config := c.config
+	c.mu.Unlock()
+
+	conn, err := c.start(ctx, config)
+	if err != nil {
+		if conn != nil {
+			c.stop(conn)
+		}
+		return fmt.Errorf("failed to connect to MQTT broker: %w", err)
+	}
+
+	c.log.Info("MQTT client connected", "broker", config.BrokerURL(), "protocol", "5")
+	return nil
+}
+
+// start creates a connection manager for config, makes it the current
+// connection and waits for the outcome of its first connection attempt.
+// The returned connection keeps retrying in the background until stopped,
+// even when an error is returned.
+func (c *V5Client) start(ctx context.Context, config *Config) (*v5Connection, error) {
+	serverURL, err := url.Parse(config.BrokerURL())
+	if err != nil {
+		c.setLastError(err)
+		return nil, err
+	}
+
+	conn := &v5Connection{broker: config.BrokerURL(), first: make(chan error, 1)}
+	cfg := autopaho.ClientConfig{
+		ServerUrls:                    []*url.URL{serverURL},
+		KeepAlive:                     uint16(DefaultKeepAlive / time.Second),
+		CleanStartOnInitialConnection: config.SessionExpiry == 0,
+		SessionExpiryInterval:         uint32(config.SessionExpiry / time.Second),
+		ConnectTimeout:                DefaultConnectTimeout,
+		ReconnectBackoff:              reconnectBackoff,
+		ConnectUsername:               config.Username,
+		ConnectPassword:               []byte(config.Password),
+		ConnectPacketBuilder: func(cp *paho.Connect, _ *url.URL) (*paho.Connect, error) {
+			c.connecting(conn)
+			return cp, nil
+		},
+		OnConnectionUp: func(cm *autopaho.ConnectionManager, connack *paho.Connack) {
+			c.connectionUp(conn, cm, connack)
+		},
+		OnConnectError: func(err error) {
+			c.connectError(conn, err)
+		},
+		ClientConfig: paho.ClientConfig{
+			ClientID:      config.ClientID,
+			PacketTimeout: DefaultWriteTimeout,
+			OnPublishReceived: []func(paho.PublishReceived) (bool, error){
+				func(pr paho.PublishReceived) (bool, error) {
+					c.enqueue(pr.Packet.Topic, pr.Packet.Payload)
+					return true, nil
+				},
+			},
+			OnClientError: func(err error) {
+				c.connectionLost(conn, err)
+			},
+			OnServerDisconnect: func(d *paho.Disconnect) {
+				err := &ReasonCodeError{Packet: "DISCONNECT", Code: ReasonCode(d.ReasonCode)}
+				if d.Properties != nil {
+					err.Reason = d.Properties.ReasonString
+				}
+				c.connectionLost(conn, err)
+			},
+		},
+	}
+	if config.UseTLS {
+		cfg.TlsCfg = &tls.Config{ServerName: config.Broker, MinVersion: tls.VersionTLS12}
+	}
+
+	c.mu.Lock()
+	c.conn = conn
+	c.mu.Unlock()
+
+	cm, err := autopaho.NewConnection(context.Background(), cfg)
+	if err != nil {
+		c.mu.Lock()
+		if c.conn == conn {
+			c.conn = nil
+		}
+		c.lastErr = err
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	c.mu.Lock()
+	conn.cm = cm
+	c.mu.Unlock()
+
+	select {
+	case err := <-conn.first:
+		return conn, err
+	case <-ctx.Done():
+		return conn, ctx.Err()
+	}
+}
+
+// stop shuts down conn and clears it if it is still the current connection.
+func (c *V5Client) stop(conn *v5Connection) {
+	c.mu.Lock()
+	if c.conn == conn {
+		c.conn = nil
+		c.connected = false
+	}
+	cm := conn.cm
+	c.mu.Unlock()
+
+	if cm != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultWriteTimeout)
+		defer cancel()
+		_ = cm.Disconnect(ctx)
+	}
+	metrics.MQTTConnected.Set(0)
+}
+
+// reconnectBackoff waits 5s before the first reconnection attempt and doubles
+// the delay up to DefaultMaxReconnectInterval. The first attempt of a new
+// connection is made immediately.
+func reconnectBackoff(attempt int) time.Duration {
+	if attempt <= 0 {
+		return 0
+	}
+	backoff := 5 * time.Second
+	for i := 1; i < attempt && backoff < DefaultMaxReconnectInterval; i++ {
+		backoff *= 2
+	}
+	if backoff > DefaultMaxReconnectInterval {
+		backoff = DefaultMaxReconnectInterval
+	}
+	return backoff
+}
+
+// connecting is called before every connection attempt.
+func (c *V5Client) connecting(conn *v5Connection) {
+	c.mu.RLock()
+	reconnect := c.conn == conn && conn.up
+	c.mu.RUnlock()
+
+	if reconnect {
+		c.log.Info("MQTT attempting reconnection", "broker", conn.broker)
+		metrics.MQTTReconnectsTotal.Inc()
+	}
+}
+
+// connectionUp records the broker limits from CONNACK and restores
+// subscriptions.
+func (c *V5Client) connectionUp(conn *v5Connection, cm *autopaho.ConnectionManager, connack *paho.Connack) {
+	c.mu.Lock()
+	if c.conn != conn {
+		c.mu.Unlock()
+		return
+	}
+	conn.cm = cm
+	conn.up = true
+	c.connected = true
+	c.lastErr = nil
+	c.maxQoS = 2
+	c.noRetain = false
+	if props := connack.Properties; props != nil {
+		if props.MaximumQoS != nil {
+			c.maxQoS = *props.MaximumQoS
+		}
+		c.noRetain = !props.RetainAvailable
+	}
+	c.mu.Unlock()
+
+	metrics.MQTTConnected.Set(1)
+	c.log.Info("MQTT connected", "broker", conn.broker)
+
+	select {
+	case conn.first <- nil:
+	default:
+	}
+
+	// This also restores subscriptions made before a credential rotation.
+	if c.hasSubscriptions() {
+		go c.resubscribe(cm)
+	}
+}
+
+// connectError records a failed connection attempt.
+func (c *V5Client) connectError(conn *v5Connection, err error) {
+	var connackErr *autopaho.ConnackError
+	if errors.As(err, &connackErr) {
+		err = &ReasonCodeError{Packet: "CONNACK", Code: ReasonCode(connackErr.ReasonCode), Reason: connackErr.Reason}
+	}
+
+	c.mu.Lock()
+	if c.conn == conn {
+		c.lastErr = err
+	}
+	c.mu.Unlock()
+
+	select {
+	case conn.first <- err:
+	default:
+	}
+}
+
+// connectionLost marks the client disconnected; autopaho reconnects on its own.
+func (c *V5Client) connectionLost(conn *v5Connection, err error) {
+	c.mu.Lock()
+	if c.conn != conn || !c.connected {
+		c.mu.Unlock()
+		return
+	}
+	c.connected = false
+	disconnecting := c.disconnecting
+	if !disconnecting {
+		c.lastErr = err
+	}
+	c.mu.Unlock()
+
+	metrics.MQTTConnected.Set(0)
+	if !disconnecting {
+		c.log.Error(err, "MQTT connection lost, will auto-reconnect", "broker", conn.broker)
+	}
+}
+
+// enqueue queues a received message for dispatchLoop. It never blocks, so
+// the paho router can go on acknowledging packets while handlers run.
+func (c *V5Client) enqueue(topic string, payload []byte) {
+	c.queueMu.Lock()
+	c.queue = append(c.queue, v5Message{topic: topic, payload: payload})
+	c.queueMu.Unlock()
+
+	select {
+	case c.queueReady <- struct{}{}:
+	default:
+	}
+}
+
+// dispatchLoop passes queued messages to the subscription handlers in the
+// order they were received, until stop is closed.
+func (c *V5Client) dispatchLoop(stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-c.queueReady:
+		}
+
+		c.queueMu.Lock()
+		msgs := c.queue
+		c.queue = nil
+		c.queueMu.Unlock()
+
+		for _, msg := range msgs {
+			c.deliver(msg)
+		}
+	}
+}
+
+// deliver passes a message to every matching subscription handler.
+func (c *V5Client) deliver(msg v5Message) {
+	c.subsMu.RLock()
+	var handlers []MessageHandler
+	for filter, sub := range c.subscriptions {
+		if topicMatchesFilter(msg.topic, filter) {
+			handlers = append(handlers, sub.handler)
+		}
+	}
+	c.subsMu.RUnlock()
+
+	for _, h := range handlers {
+		h(msg.topic, msg.payload)
+	}
+}
+
+func (c *V5Client) hasSubscriptions() bool {
+	c.subsMu.RLock()
+	defer c.subsMu.RUnlock()
+	return len(c.subscriptions) > 0
+}
+
+// resubscribe restores all subscriptions on cm.
+func (c *V5Client) resubscribe(cm *autopaho.ConnectionManager) {
+	c.subsMu.RLock()
+	subs := make(map[string]v5Subscription, len(c.subscriptions))
+	for filter, sub := range c.subscriptions {
+		subs[filter] = sub
+	}
+	c.subsMu.RUnlock()
+
+	for filter, sub := range subs {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultWriteTimeout)
+		if err := subscribe(ctx, cm, filter, sub.qos); err != nil {
+			c.log.Error(err, "Failed to restore MQTT subscription", "topic", filter)
+		}
+		cancel()
+	}
+}
+
+// currentConnection returns the connection manager of the current
+// connection or an error if the client is not connected.
+func (c *V5Client) currentConnection() (*autopaho.ConnectionManager, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.connected || c.conn == nil || c.conn.cm == nil {
+		return nil, fmt.Errorf("MQTT client not connected")
+	}
+	return c.conn.cm, nil
+}
+
+func (c *V5Client) setLastError(err error) {
+	c.mu.Lock()
+	c.lastErr = err
+	c.mu.Unlock()
+}
+
+// Disconnect closes the MQTT connection.
+func (c *V5Client) Disconnect() {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
+	c.mu.Lock()
+	c.disconnecting = true
+	conn := c.conn
+	connected := c.connected
+	stop := c.dispatchStop
+	c.dispatchStop = nil
+	c.mu.Unlock()
+
+	if conn != nil {
+		c.stop(conn)
+	}
+	if stop != nil {
+		close(stop)
+	}
+	if connected {
+		c.log.Info("MQTT client disconnected")
+	}
+}
+
+// UpdateCredentials replaces the username and password and reconnects.
+// It follows the same drain-then-reconnect sequence as PahoClient.UpdateCredentials.
+func (c *V5Client) UpdateCredentials(ctx context.Context, username, password string) error {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
+	c.mu.Lock()
+	if c.disconnecting {
+		c.mu.Unlock()
+		return fmt.Errorf("MQTT client is disconnecting")
+	}
+	if c.config.Username == username && c.config.Password == password {
+		c.mu.Unlock()
+		return nil
+	}
+	cfg := *c.config
+	cfg.Username = username
+	cfg.Password = password
+	c.config = &cfg
+	old := c.conn
+	// New publishes wait for the new connection, so none start while draining.
+	c.connected = false
+	c.mu.Unlock()
+
+	c.log.Info("MQTT credentials changed, rebuilding client", "broker", cfg.BrokerURL(), "username", username)
+
+	if !waitTimeout(&c.inflight, DefaultDrainTimeout) {
+		c.log.Info("Timed out draining in-flight publishes before rebuild", "timeout", DefaultDrainTimeout)
+	}
+
+	// Stop the old connection manager even when it is reconnecting.
+	if old != nil {
+		c.stop(old)
+	}
+
+	if _, err := c.start(ctx, &cfg); err != nil {
+		c.log.Error(err, "MQTT reconnect with new credentials failed, will keep retrying")
+		return err
+	}
+
+	c.log.Info("MQTT client reconnected with new credentials", "broker", cfg.BrokerURL())
+	return nil
+}
+
+// Publish sends a message to the specified topic.
+// If not connected, it waits for reconnection up to DefaultReconnectWaitTimeout.
+// A failure reason code in PUBACK or PUBREC is returned as *ReasonCodeError.
+func (c *V5Client) Publish(ctx context.Context, topic string, payload []byte, qos byte, retain bool) error {
+	if err := c.WaitForConnection(ctx); err != nil {
+		return err
+	}
+
+	c.mu.RLock()
+	var cm *autopaho.ConnectionManager
+	if c.connected && c.conn != nil {
+		cm = c.conn.cm
+	}
+	maxQoS, noRetain := c.maxQoS, c.noRetain
+	if cm != nil {
+		c.inflight.Add(1)
+	}
+	c.mu.RUnlock()
+
+	if cm == nil {
+		return fmt.Errorf("MQTT client not connected")
+	}
+	defer c.inflight.Done()
+	if retain && noRetain {
+		return &ReasonCodeError{Packet: "PUBLISH", Code: ReasonRetainNotSupported, Reason: "broker does not support retained messages"}
+	}
+	if qos > maxQoS {
+		c.log.V(1).Info("Downgrading QoS to broker maximum", "topic", topic, "qos", qos, "maximumQoS", maxQoS)
+		qos = maxQoS
+	}
+
+	opts := publishOptionsFrom(ctx)
+	pub := &paho.Publish{
+		Topic:      topic,
+		QoS:        qos,
+		Retain:     retain,
+		Payload:    payload,
+		Properties: &paho.PublishProperties{},
+	}
+	for _, p := range opts.userProperties {
+		pub.Properties.User.Add(p.Key, p.Value)
+	}
+	if opts.messageExpiry > 0 {
+		expiry := uint32(opts.messageExpiry / time.Second)
+		pub.Properties.MessageExpiry = &expiry
+	}
+
+	resp, err := cm.Publish(ctx, pub)
+	if resp != nil && ReasonCode(resp.ReasonCode).IsError() {
+		rcErr := &ReasonCodeError{Packet: "PUBACK", Code: ReasonCode(resp.ReasonCode)}
+		if qos == 2 {
+			rcErr.Packet = "PUBREC"
+		}
+		if resp.Properties != nil {
+			rcErr.Reason = resp.Properties.ReasonString
+		}
+		return fmt.Errorf("failed to publish to %s: %w", topic, rcErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to publish to %s: %w", topic, err)
+	}
+
+	c.log.V(1).Info("Published MQTT message", "topic", topic, "retain", retain, "qos", qos)
+	return nil
+}
+
+// WaitForConnection waits for the MQTT client to be connected.
+// Returns immediately if already connected, otherwise waits for reconnection.
+func (c *V5Client) WaitForConnection(ctx context.Context) error {
+	c.mu.RLock()
+	connected := c.connected
+	disconnecting := c.disconnecting
+	c.mu.RUnlock()
+
+	if disconnecting {
+		return fmt.Errorf("MQTT client is disconnecting")
+	}
+	if connected {
+		return nil
+	}
+
+	c.log.Info("Waiting for MQTT reconnection before publish")
+
+	start := time.Now()
+	defer func() { metrics.MQTTConnectionWait.Observe(time.Since(start).Seconds()) }()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	timeout := time.NewTimer(DefaultReconnectWaitTimeout)
+	defer timeout.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-timeout.C:
+			return fmt.Errorf("timeout waiting for MQTT reconnection after %v", DefaultReconnectWaitTimeout)
+
+		case <-ticker.C:
+			c.mu.RLock()
+			connected := c.connected
+			disconnecting := c.disconnecting
+			c.mu.RUnlock()
+
+			if disconnecting {
+				return fmt.Errorf("MQTT client is disconnecting")
+			}
+			if connected {
+				c.log.Info("MQTT reconnected, proceeding with publish")
+				return nil
+			}
+		}
+	}
+}
+
+// Subscribe subscribes to a topic with the given QoS and message handler.
+// The subscription is restored automatically after a reconnect.
+func (c *V5Client) Subscribe(ctx context.Context, topic string, qos byte, handler MessageHandler) error {
+	if err := c.WaitForConnection(ctx); err != nil {
+		return err
+	}
+	cm, err := c.currentConnection()
+	if err != nil {
+		return err
+	}
+
+	// Register the handler first: retained messages may arrive before SUBACK.
+	c.subsMu.Lock()
+	c.subscriptions[topic] = v5Subscription{qos: qos, handler: handler}
+	c.subsMu.Unlock()
+
+	if err := subscribe(ctx, cm, topic, qos); err != nil {
+		c.subsMu.Lock()
+		delete(c.subscriptions, topic)
+		c.subsMu.Unlock()
+		return err
+	}
+
+	c.log.V(1).Info("Subscribed to MQTT topic", "topic", topic, "qos", qos)
+	return nil
+}
+
+// subscribe sends SUBSCRIBE for a single filter and checks the SUBACK.
+func subscribe(ctx context.Context, cm *autopaho.ConnectionManager, topic string, qos byte) error {
+	suback, err := cm.Subscribe(ctx, &paho.Subscribe{
+		Subscriptions: []paho.SubscribeOptions{{Topic: topic, QoS: qos}},
+	})
+	if suback != nil && len(suback.Reasons) > 0 && ReasonCode(suback.Reasons[0]).IsError() {
+		rcErr := &ReasonCodeError{Packet: "SUBACK", Code: ReasonCode(suback.Reasons[0])}
+		if suback.Properties != nil {
+			rcErr.Reason = suback.Properties.ReasonString
+		}
+		return fmt.Errorf("failed to subscribe to %s: %w", topic, rcErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", topic, err)
+	}
+	return nil
+}
+
+// Unsubscribe unsubscribes from the given topics.
+func (c *V5Client) Unsubscribe(ctx context.Context, topics ...string) error {
+	c.subsMu.Lock()
+	for _, t := range topics {
+		delete(c.subscriptions, t)
+	}
+	c.subsMu.Unlock()
+
+	cm, err := c.currentConnection()
+	if err != nil {
+		return err
+	}
+
+	unsuback, err := cm.Unsubscribe(ctx, &paho.Unsubscribe{Topics: topics})
+	if unsuback != nil {
+		for _, code := range unsuback.Reasons {
+			if ReasonCode(code).IsError() {
+				rcErr := &ReasonCodeError{Packet: "UNSUBACK", Code: ReasonCode(code)}
+				if unsuback.Properties != nil {
+					rcErr.Reason = unsuback.Properties.ReasonString
+				}
+				return fmt.Errorf("failed to unsubscribe: %w", rcErr)
+			}
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	c.log.V(1).Info("Unsubscribed from MQTT topics", "topics", topics)
+	return nil
+}
+
+// IsConnected returns whether the client is connected.
+func (c *V5Client) IsConnected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.connected
+}
+
+// LastError returns the most recent connection error, or nil if connected.
+func (c *V5Client) LastError() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastErr
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.golang/packets"
+	"github.com/go-logr/logr"
+)
+
+// fakeBroker is a minimal MQTT v5 broker for exercising V5Client. It starts
+// accepting connections on the first call to config, so fields set before
+// that need no locking.
+type fakeBroker struct {
+	t        *testing.T
+	listener net.Listener
+	serving  sync.Once
+
+	// connackCode is returned in CONNACK.
+	connackCode ReasonCode
+	// pubackCode is returned in PUBACK for every QoS 1 publish.
+	pubackCode ReasonCode
+	// retained is sent to every new subscriber before SUBACK.
+	retained map[string][]byte
+	// stall makes the broker read CONNECT and never answer it.
+	stall bool
+
+	mu        sync.Mutex
+	connects  []*packets.Connect
+	published []*packets.Publish
+}
+
+func newFakeBroker(t *testing.T) *fakeBroker {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	b := &fakeBroker{t: t, listener: l, retained: map[string][]byte{}}
+	t.Cleanup(func() { _ = l.Close() })
+	return b
+}
+
+func (b *fakeBroker) config() *Config {
+	b.serving.Do(func() { go b.serve() })
+	host, portStr, _ := net.SplitHostPort(b.listener.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	return &Config{Broker: host, Port: port, ClientID: "test", ProtocolVersion: ProtocolVersion5}
+}
+
+func (b *fakeBroker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		go b.handle(conn)
+	}
+}
+
+func (b *fakeBroker) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	pkt, err := packets.ReadPacket(conn)
+	if err != nil || pkt.Type != packets.CONNECT {
+		return
+	}
+	b.mu.Lock()
+	b.connects = append(b.connects, pkt.Content.(*packets.Connect))
+	code, stall := b.connackCode, b.stall
+	b.mu.Unlock()
+
+	if stall {
+		_, _ = packets.ReadPacket(conn)
+		return
+	}
+
+	connack := &packets.Connack{ReasonCode: byte(code), Properties: &packets.Properties{}}
+	if _, err := connack.WriteTo(conn); err != nil || code.IsError() {
+		return
+	}
+
+	for {
+		pkt, err := packets.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		switch p := pkt.Content.(type) {
+		case *packets.Publish:
+			b.mu.Lock()
+			b.published = append(b.published, p)
+			code := b.pubackCode
+			b.mu.Unlock()
+			if p.QoS > 0 {
+				puback := &packets.Puback{PacketID: p.PacketID, ReasonCode: byte(code), Properties: &packets.Properties{}}
+				_, _ = puback.WriteTo(conn)
+			}
+		case *packets.Subscribe:
+			filter := p.Subscriptions[0].Topic
+
+			b.mu.Lock()
+			for topic, payload := range b.retained {
+				if topicMatchesFilter(topic, filter) {
+					pub := &packets.Publish{Topic: topic, Retain: true, Payload: payload, Properties: &packets.Properties{}}
+					_, _ = pub.WriteTo(conn)
+				}
+			}
+			b.mu.Unlock()
+
+			suback := &packets.Suback{PacketID: p.PacketID, Reasons: []byte{0}, Properties: &packets.Properties{}}
+			_, _ = suback.WriteTo(conn)
+		case *packets.Pingreq:
+			_, _ = packets.NewControlPacket(packets.PINGRESP).WriteTo(conn)
+		case *packets.Disconnect:
+			return
+		}
+	}
+}
+
+func TestV5Client_Connect(t *testing.T) {
+	broker := newFakeBroker(t)
+	cfg := broker.config()
+	cfg.Username = "ha"
+	cfg.Password = "secret"
+	cfg.SessionExpiry = time.Hour
+
+	c := NewV5Client(cfg, logr.Discard())
+	if err := c.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error: %v", err)
+	}
+	defer c.Disconnect()
+
+	if !c.IsConnected() {
+		t.Error("IsConnected() = false after Connect")
+	}
+
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+	if len(broker.connects) != 1 {
+		t.Fatalf("broker saw %d connects, want 1", len(broker.connects))
+	}
+	connect := broker.connects[0]
+	if connect.Username != "ha" || string(connect.Password) != "secret" {
+		t.Errorf("credentials = %q/%q, want ha/secret", connect.Username, connect.Password)
+	}
+	if connect.CleanStart {
+		t.Error("clean start set with a session expiry configured")
+	}
+	if se := connect.Properties.SessionExpiryInterval; se == nil || *se != 3600 {
+		t.Errorf("session expiry = %v, want 3600", se)
+	}
+}
+
+func TestV5Client_ConnectBadCredentials(t *testing.T) {
+	broker := newFakeBroker(t)
+	broker.connackCode = ReasonBadUserNameOrPassword
+
+	c := NewV5Client(broker.config(), logr.Discard())
+	err := c.Connect(context.Background())
+	if err == nil {
+		t.Fatal("Connect() succeeded, want error")
+	}
+	if !IsReasonCode(err, ReasonBadUserNameOrPassword) {
+		t.Errorf("Connect() error = %v, want reason code %s", err, ReasonBadUserNameOrPassword)
+	}
+	if c.IsConnected() {
+		t.Error("IsConnected() = true after failed Connect")
+	}
+	if c.LastError() == nil {
+		t.Error("LastError() = nil after failed Connect")
+	}
+}
+
+func TestV5Client_Publish(t *testing.T) {
+	tests := []struct {
+		name       string
+		pubackCode ReasonCode
+		wantCode   ReasonCode
+	}{
+		{name: "accepted", pubackCode: ReasonSuccess},
+		{name: "not authorized", pubackCode: ReasonNotAuthorized, wantCode: ReasonNotAuthorized},
+		{name: "quota exceeded", pubackCode: ReasonQuotaExceeded, wantCode: ReasonQuotaExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := newFakeBroker(t)
+			broker.pubackCode = tt.pubackCode
+
+			c := NewV5Client(broker.config(), logr.Discard())
+			if err := c.Connect(context.Background()); err != nil {
+				t.Fatalf("Connect() error: %v", err)
+			}
+			defer c.Disconnect()
+
+			ctx := WithUserProperties(context.Background(), UserProperty{Key: "cr-uid", Value: "1234"})
+			err := c.Publish(ctx, "homeassistant/button/x/config", []byte("{}"), 1, true)
+
+			if tt.wantCode == ReasonSuccess {
+				if err != nil {
+					t.Fatalf("Publish() error: %v", err)
+				}
+			} else {
+				var rcErr *ReasonCodeError
+				if !errors.As(err, &rcErr) || rcErr.Code != tt.wantCode {
+					t.Fatalf("Publish() error = %v, want reason code %s", err, tt.wantCode)
+				}
+			}
+
+			broker.mu.Lock()
+			defer broker.mu.Unlock()
+			if len(broker.published) != 1 {
+				t.Fatalf("broker saw %d publishes, want 1", len(broker.published))
+			}
+			props := broker.published[0].Properties.User
+			if len(props) != 1 || props[0] != (packets.User{Key: "cr-uid", Value: "1234"}) {
+				t.Errorf("user properties = %v, want [cr-uid=1234]", props)
+			}
+		})
+	}
+}
+
+func TestV5Client_SubscribeRetained(t *testing.T) {
+	broker := newFakeBroker(t)
+	broker.retained["homeassistant/sensor/a/config"] = []byte(`{"name":"a"}`)
+	broker.retained["other/topic"] = []byte("ignored")
+
+	c := NewV5Client(broker.config(), logr.Discard())
+	if err := c.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error: %v", err)
+	}
+	defer c.Disconnect()
+
+	received := make(chan string, 2)
+	err := c.Subscribe(context.Background(), "homeassistant/+/+/config", 0, func(topic string, _ []byte) {
+		received <- topic
+	})
+	if err != nil {
+		t.Fatalf("Subscribe() error: %v", err)
+	}
+
+	select {
+	case topic := <-received:
+		if topic != "homeassistant/sensor/a/config" {
+			t.Errorf("received %q, want homeassistant/sensor/a/config", topic)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("retained message not delivered")
+	}
+
+	select {
+	case topic := <-received:
+		t.Errorf("unexpected message on %q", topic)
+	default:
+	}
+}
+
+func TestV5Client_HandlerPublishes(t *testing.T) {
+	broker := newFakeBroker(t)
+	for _, name := range []string{"a", "b", "c"} {
+		broker.retained["homeassistant/sensor/"+name+"/config"] = []byte(`{}`)
+	}
+
+	c := NewV5Client(broker.config(), logr.Discard())
+	if err := c.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error: %v", err)
+	}
+	defer c.Disconnect()
+
+	// A handler that publishes at QoS 1 waits for PUBACK, which must still
+	// be read while the handler runs.
+	done := make(chan error, 3)
+	err := c.Subscribe(context.Background(), "homeassistant/+/+/config", 1, func(topic string, _ []byte) {
+		done <- c.Publish(context.Background(), topic+"/ack", []byte("ok"), 1, false)
+	})
+	if err != nil {
+		t.Fatalf("Subscribe() error: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Publish() from handler error: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Publish() from handler did not complete")
+		}
+	}
+}
+
+func TestV5Client_UpdateCredentialsDoesNotBlock(t *testing.T) {
+	broker := newFakeBroker(t)
+
+	c := NewV5Client(broker.config(), logr.Discard())
+	if err := c.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error: %v", err)
+	}
+	defer c.Disconnect()
+
+	broker.mu.Lock()
+	broker.stall = true
+	broker.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	rotated := make(chan error, 1)
+	go func() { rotated <- c.UpdateCredentials(ctx, "ha", "rotated") }()
+
+	// Wait until the new connection is waiting for CONNACK.
+	deadline := time.Now().Add(time.Second)
+	for {
+		broker.mu.Lock()
+		n := len(broker.connects)
+		broker.mu.Unlock()
+		if n == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("broker did not see the reconnect")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	state := make(chan bool, 1)
+	go func() {
+		_ = c.LastError()
+		state <- c.IsConnected()
+	}()
+	select {
+	case connected := <-state:
+		if connected {
+			t.Error("IsConnected() = true while reconnecting")
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("IsConnected() blocked during the CONNECT handshake")
+	}
+
+	if err := <-rotated; err == nil {
+		t.Error("UpdateCredentials() succeeded against a stalled broker, want error")
+	}
+}
+
+func TestReasonCodeError(t *testing.T) {
+	err := &ReasonCodeError{Packet: "PUBACK", Code: ReasonNotAuthorized, Reason: "acl denied"}
+	want := "PUBACK: Not authorized (0x87): acl denied"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestNewConfigFromEnv_ProtocolVersion(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{value: "", want: ProtocolVersion311},
+		{value: "3.1.1", want: ProtocolVersion311},
+		{value: "5", want: ProtocolVersion5},
+		{value: "5.0", want: ProtocolVersion5},
+		{value: "6", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("MQTT_BROKER", "broker.local")
+			t.Setenv("MQTT_PROTOCOL_VERSION", tt.value)
+
+			cfg, err := NewConfigFromEnv()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("NewConfigFromEnv() succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewConfigFromEnv() error: %v", err)
+			}
+			if cfg.ProtocolVersion != tt.want {
+				t.Errorf("ProtocolVersion = %d, want %d", cfg.ProtocolVersion, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ReasonCode is an MQTT v5 reason code. Values below 0x80 indicate success.
+type ReasonCode byte
+
+// Reason codes defined by MQTT v5 (section 2.4).
+const (
+	ReasonSuccess                         ReasonCode = 0x00
+	ReasonGrantedQoS1                     ReasonCode = 0x01
+	ReasonGrantedQoS2                     ReasonCode = 0x02
+	ReasonNoMatchingSubscribers           ReasonCode = 0x10
+	ReasonNoSubscriptionExisted           ReasonCode = 0x11
+	ReasonUnspecifiedError                ReasonCode = 0x80
+	ReasonMalformedPacket                 ReasonCode = 0x81
+	ReasonProtocolError                   ReasonCode = 0x82
+	ReasonImplementationSpecificError     ReasonCode = 0x83
+	ReasonUnsupportedProtocolVersion      ReasonCode = 0x84
+	ReasonClientIdentifierNotValid        ReasonCode = 0x85
+	ReasonBadUserNameOrPassword           ReasonCode = 0x86
+	ReasonNotAuthorized                   ReasonCode = 0x87
+	ReasonServerUnavailable               ReasonCode = 0x88
+	ReasonServerBusy                      ReasonCode = 0x89
+	ReasonBanned                          ReasonCode = 0x8A
+	ReasonServerShuttingDown              ReasonCode = 0x8B
+	ReasonBadAuthenticationMethod         ReasonCode = 0x8C
+	ReasonKeepAliveTimeout                ReasonCode = 0x8D
+	ReasonSessionTakenOver                ReasonCode = 0x8E
+	ReasonTopicFilterInvalid              ReasonCode = 0x8F
+	ReasonTopicNameInvalid                ReasonCode = 0x90
+	ReasonPacketIdentifierInUse           ReasonCode = 0x91
+	ReasonPacketIdentifierNotFound        ReasonCode = 0x92
+	ReasonReceiveMaximumExceeded          ReasonCode = 0x93
+	ReasonTopicAliasInvalid               ReasonCode = 0x94
+	ReasonPacketTooLarge                  ReasonCode = 0x95
+	ReasonMessageRateTooHigh              ReasonCode = 0x96
+	ReasonQuotaExceeded                   ReasonCode = 0x97
+	ReasonAdministrativeAction            ReasonCode = 0x98
+	ReasonPayloadFormatInvalid            ReasonCode = 0x99
+	ReasonRetainNotSupported              ReasonCode = 0x9A
+	ReasonQoSNotSupported                 ReasonCode = 0x9B
+	ReasonUseAnotherServer                ReasonCode = 0x9C
+	ReasonServerMoved                     ReasonCode = 0x9D
+	ReasonSharedSubscriptionsNotSupported ReasonCode = 0x9E
+	ReasonConnectionRateExceeded          ReasonCode = 0x9F
+	ReasonMaximumConnectTime              ReasonCode = 0xA0
+	ReasonSubscriptionIDsNotSupported     ReasonCode = 0xA1
+	ReasonWildcardSubscriptionsNotSupport ReasonCode = 0xA2
+)
+
+var reasonCodeNames = map[ReasonCode]string{
+	ReasonSuccess:                         "Success",
+	ReasonGrantedQoS1:                     "Granted QoS 1",
+	ReasonGrantedQoS2:                     "Granted QoS 2",
+	ReasonNoMatchingSubscribers:           "No matching subscribers",
+	ReasonNoSubscriptionExisted:           "No subscription existed",
+	ReasonUnspecifiedError:                "Unspecified error",
+	ReasonMalformedPacket:                 "Malformed packet",
+	ReasonProtocolError:                   "Protocol error",
+	ReasonImplementationSpecificError:     "Implementation specific error",
+	ReasonUnsupportedProtocolVersion:      "Unsupported protocol version",
+	ReasonClientIdentifierNotValid:        "Client identifier not valid",
+	ReasonBadUserNameOrPassword:           "Bad user name or password",
+	ReasonNotAuthorized:                   "Not authorized",
+	ReasonServerUnavailable:               "Server unavailable",
+	ReasonServerBusy:                      "Server busy",
+	ReasonBanned:                          "Banned",
+	ReasonServerShuttingDown:              "Server shutting down",
+	ReasonBadAuthenticationMethod:         "Bad authentication method",
+	ReasonKeepAliveTimeout:                "Keep alive timeout",
+	ReasonSessionTakenOver:                "Session taken over",
+	ReasonTopicFilterInvalid:              "Topic filter invalid",
+	ReasonTopicNameInvalid:                "Topic name invalid",
+	ReasonPacketIdentifierInUse:           "Packet identifier in use",
+	ReasonPacketIdentifierNotFound:        "Packet identifier not found",
+	ReasonReceiveMaximumExceeded:          "Receive maximum exceeded",
+	ReasonTopicAliasInvalid:               "Topic alias invalid",
+	ReasonPacketTooLarge:                  "Packet too large",
+	ReasonMessageRateTooHigh:              "Message rate too high",
+	ReasonQuotaExceeded:                   "Quota exceeded",
+	ReasonAdministrativeAction:            "Administrative action",
+	ReasonPayloadFormatInvalid:            "Payload format invalid",
+	ReasonRetainNotSupported:              "Retain not supported",
+	ReasonQoSNotSupported:                 "QoS not supported",
+	ReasonUseAnotherServer:                "Use another server",
+	ReasonServerMoved:                     "Server moved",
+	ReasonSharedSubscriptionsNotSupported: "Shared subscriptions not supported",
+	ReasonConnectionRateExceeded:          "Connection rate exceeded",
+	ReasonMaximumConnectTime:              "Maximum connect time",
+	ReasonSubscriptionIDsNotSupported:     "Subscription identifiers not supported",
+	ReasonWildcardSubscriptionsNotSupport: "Wildcard subscriptions not supported",
+}
+
+// String returns the reason code name from the MQTT v5 specification.
+func (r ReasonCode) String() string {
+	if name, ok := reasonCodeNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Reason code 0x%02X", byte(r))
+}
+
+// IsError reports whether the reason code indicates failure.
+func (r ReasonCode) IsError() bool {
+	return r >= 0x80
+}
+
+// IsPermanent reports whether a publish refused with r is refused again when
+// retried unchanged, e.g. one denied by an ACL or too large for the broker.
+func (r ReasonCode) IsPermanent() bool {
+	switch r {
+	case ReasonNotAuthorized, ReasonTopicNameInvalid, ReasonPacketTooLarge,
+		ReasonPayloadFormatInvalid, ReasonRetainNotSupported, ReasonQoSNotSupported:
+		return true
+	}
+	return false
+}
+
+// ReasonCodeError is returned when the broker answers a request with a
+// failure reason code, e.g. a PUBACK rejecting a publish denied by an ACL.
+type ReasonCodeError struct {
+	// Packet is the packet carrying the reason code (CONNACK, PUBACK, ...).
+	Packet string
+	// Code is the reason code sent by the broker.
+	Code ReasonCode
+	// Reason is the optional human-readable reason string sent by the broker.
+	Reason string
+}
+
+func (e *ReasonCodeError) Error() string {
+	msg := fmt.Sprintf("%s: %s (0x%02X)", e.Packet, e.Code, byte(e.Code))
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
+}
+
+// IsReasonCode reports whether err wraps a ReasonCodeError with the given code.
+func IsReasonCode(err error, code ReasonCode) bool {
+	var rcErr *ReasonCodeError
+	return errors.As(err, &rcErr) && rcErr.Code == code
+}
+
+// UserProperty is an MQTT v5 user property (a UTF-8 key/value pair).
+type UserProperty struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
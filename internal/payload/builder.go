@@ -18,8 +18,12 @@ package payload
 
 import (
 	"encoding/json"
+	"slices"
+	"sort"
 	"strings"
 	"unicode"
+
+	"github.com/spontus/hass-crds/internal/version"
 )
 
 const (
@@ -30,10 +34,23 @@ const (
 	OriginSupportURL = "https://github.com/spontus/hass-crds"
 )
 
-// DefaultOrigin returns the default origin block for discovery payloads.
-func DefaultOrigin() map[string]interface{} {
+// OriginNameFor returns the origin name used by the controller instance
+// instanceID: OriginName for the default instance and OriginName/<instanceID>
+// otherwise. Home Assistant rejects unknown origin keys, so the instance is
+// carried in the name.
+func OriginNameFor(instanceID string) string {
+	if instanceID == "" {
+		return OriginName
+	}
+	return OriginName + "/" + instanceID
+}
+
+// DefaultOrigin returns the origin block for discovery payloads of the
+// controller instance instanceID.
+func DefaultOrigin(instanceID string) map[string]interface{} {
 	return map[string]interface{}{
-		"name":        OriginName,
+		"name":        OriginNameFor(instanceID),
+		"sw_version":  version.Version,
 		"support_url": OriginSupportURL,
 	}
 }
@@ -112,10 +129,13 @@ func (b *Builder) SetDevice(device map[string]interface{}) *Builder {
 	return b
 }
 
-// SetAvailability adds availability configuration to the payload.
+// SetAvailability adds availability configuration to the payload. It
+// replaces availability_topic, which Home Assistant does not accept
+// together with availability.
 func (b *Builder) SetAvailability(availability []map[string]interface{}) *Builder {
 	if len(availability) > 0 {
 		b.data["availability"] = availability
+		delete(b.data, "availability_topic")
 	}
 	return b
 }
@@ -128,6 +148,26 @@ func (b *Builder) SetOrigin(origin map[string]interface{}) *Builder {
 	return b
 }
 
+// ReservedKeys are the keys the controller sets, with their abbreviations.
+// Merge does not override them.
+var ReservedKeys = []string{"unique_id", "uniq_id", "device", "dev", "origin", "o"}
+
+// Merge adds the keys of extra to the payload as they are, overriding keys
+// already set except for ReservedKeys. It returns the reserved keys that
+// were left out, sorted.
+func (b *Builder) Merge(extra map[string]interface{}) []string {
+	var ignored []string
+	for key, value := range extra {
+		if slices.Contains(ReservedKeys, key) {
+			ignored = append(ignored, key)
+			continue
+		}
+		b.data[key] = value
+	}
+	sort.Strings(ignored)
+	return ignored
+}
+
 // Build returns the payload as JSON bytes.
 func (b *Builder) Build() ([]byte, error) {
 	return json.Marshal(b.data)
@@ -18,6 +18,7 @@ package payload
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -127,6 +128,51 @@ func TestBuilder_Build(t *testing.T) {
 	}
 }
 
+func TestBuilder_Merge(t *testing.T) {
+	b := New()
+	b.Set("commandTopic", "test/cmd")
+	b.Set("uniqueId", "id")
+	b.SetOrigin(DefaultOrigin(""))
+
+	ignored := b.Merge(map[string]interface{}{
+		"command_topic": "other/cmd",
+		"white_scale":   100,
+		"uniq_id":       "other",
+		"origin":        map[string]interface{}{"name": "other"},
+	})
+
+	if want := []string{"origin", "uniq_id"}; !reflect.DeepEqual(ignored, want) {
+		t.Errorf("Merge() ignored %v, want %v", ignored, want)
+	}
+	data := b.BuildMap()
+	if data["command_topic"] != "other/cmd" || data["white_scale"] != 100 {
+		t.Errorf("extra keys not merged: %v", data)
+	}
+	if _, ok := data["uniq_id"]; ok || data["unique_id"] != "id" || data["origin"].(map[string]interface{})["name"] != OriginName {
+		t.Errorf("reserved keys overridden: %v", data)
+	}
+}
+
+func TestBuilder_SetAvailability(t *testing.T) {
+	b := New()
+	b.Set("availabilityTopic", "home/device/status")
+	data := b.BuildMap()
+	if data["availability_topic"] != "home/device/status" {
+		t.Fatalf("availability_topic = %v, want home/device/status", data["availability_topic"])
+	}
+
+	// The availability list wins over the single topic, as before payloads
+	// were built from struct tags
+	b.SetAvailability([]map[string]interface{}{AvailabilityToMap("home/device/avail", "", "", "")})
+	data = b.BuildMap()
+	if _, ok := data["availability_topic"]; ok {
+		t.Errorf("availability_topic = %v, want it left out with availability", data["availability_topic"])
+	}
+	if _, ok := data["availability"]; !ok {
+		t.Error("availability missing")
+	}
+}
+
 func TestDeviceBlockToMap(t *testing.T) {
 	device := DeviceBlockToMap(
 		"My Device",
@@ -172,3 +218,23 @@ func TestAvailabilityToMap(t *testing.T) {
 		t.Errorf("expected value_template, got %v", avail["value_template"])
 	}
 }
+
+func TestDefaultOrigin(t *testing.T) {
+	tests := []struct {
+		instanceID string
+		wantName   string
+	}{
+		{instanceID: "", wantName: "hass-crds"},
+		{instanceID: "office", wantName: "hass-crds/office"},
+	}
+
+	for _, tt := range tests {
+		origin := DefaultOrigin(tt.instanceID)
+		if origin["name"] != tt.wantName {
+			t.Errorf("DefaultOrigin(%q) name = %v, want %s", tt.instanceID, origin["name"], tt.wantName)
+		}
+		if origin["sw_version"] == "" || origin["support_url"] != OriginSupportURL {
+			t.Errorf("DefaultOrigin(%q) = %v, want sw_version and support_url", tt.instanceID, origin)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package payload
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// TagName is the struct tag that controls how a field is added by SetFields.
+const TagName = "hass"
+
+// SetFields adds the fields of the struct v, or the struct v points to, to
+// the payload. A field's key is its json name converted to snake_case, and
+// empty strings, slices, maps and nil pointers are left out as with Set.
+// Embedded structs are flattened. The hass tag overrides this per field:
+//
+//	hass:"-"            the field is not part of the payload
+//	hass:"key"          the field is added as key, e.g. an abbreviation
+//	hass:",omitzero"    the field is also left out when it holds its zero value
+//
+// Keys listed in omit are left out, for fields shared by components that do
+// not all accept them.
+func (b *Builder) SetFields(v interface{}, omit ...string) *Builder {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return b
+	}
+
+	skip := make(map[string]bool, len(omit))
+	for _, key := range omit {
+		skip[key] = true
+	}
+	b.setFields(rv, skip)
+	return b
+}
+
+func (b *Builder) setFields(rv reflect.Value, skip map[string]bool) {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := rv.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && jsonName(field) == "" {
+			b.setFields(fv, skip)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		key, omitZero := FieldKey(field)
+		if key == "" || skip[key] {
+			continue
+		}
+		if omitZero && fv.IsZero() {
+			continue
+		}
+		if value, ok := fieldValue(fv); ok {
+			b.data[key] = value
+		}
+	}
+}
+
+// Keys returns the keys SetFields can add for the struct v, or the struct v
+// points to, whatever the values of its fields, leaving out the keys in omit.
+func Keys(v interface{}, omit ...string) []string {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var keys []string
+	var walk func(t reflect.Type)
+	walk = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Anonymous && field.Type.Kind() == reflect.Struct && jsonName(field) == "" {
+				walk(field.Type)
+				continue
+			}
+			if !field.IsExported() {
+				continue
+			}
+			if key, _ := FieldKey(field); key != "" && !slices.Contains(omit, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	walk(t)
+	sort.Strings(keys)
+	return keys
+}
+
+// FieldKey returns the payload key of a struct field and whether it is left
+// out when zero. The key is empty for fields that are not part of the payload.
+func FieldKey(field reflect.StructField) (key string, omitZero bool) {
+	name, opts, _ := strings.Cut(field.Tag.Get(TagName), ",")
+	omitZero = opts == "omitzero"
+	if name == "-" || field.Tag.Get("json") == "-" {
+		return "", false
+	}
+	if name != "" {
+		return name, omitZero
+	}
+	if name = jsonName(field); name == "" {
+		name = field.Name
+	}
+	return camelToSnake(name), omitZero
+}
+
+// jsonName returns the name from the json tag of field.
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return name
+}
+
+// fieldValue returns the payload value of fv, or false if it is left out.
+func fieldValue(fv reflect.Value) (interface{}, bool) {
+	switch fv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if fv.IsNil() {
+			return nil, false
+		}
+		return fieldValue(fv.Elem())
+	case reflect.String, reflect.Slice, reflect.Map:
+		if fv.Len() == 0 {
+			return nil, false
+		}
+	case reflect.Struct:
+		nested := New()
+		nested.setFields(fv, nil)
+		if len(nested.data) == 0 {
+			return nil, false
+		}
+		return nested.data, true
+	}
+	return fv.Interface(), true
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package payload
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testMetadata struct {
+	Name string `json:"name,omitempty"`
+	Icon string `json:"icon,omitempty"`
+}
+
+type testDevice struct {
+	Model string `json:"model,omitempty"`
+}
+
+type testSpec struct {
+	testMetadata `json:",inline"`
+
+	CommandTopic string      `json:"commandTopic"`
+	Retain       *bool       `json:"retain,omitempty"`
+	Min          *float64    `json:"min,omitempty"`
+	Options      []string    `json:"options,omitempty"`
+	Precision    int         `json:"precision"`
+	Step         int         `json:"step" hass:",omitzero"`
+	StateTopic   string      `json:"stateTopic,omitempty" hass:"stat_t"`
+	Interval     string      `json:"interval,omitempty" hass:"-"`
+	Internal     string      `json:"-"`
+	Device       *testDevice `json:"device,omitempty"`
+	unexported   string
+}
+
+func TestBuilder_SetFields(t *testing.T) {
+	retain := false
+	min := 0.5
+	spec := &testSpec{
+		testMetadata: testMetadata{Name: "Porch"},
+		CommandTopic: "porch/set",
+		Retain:       &retain,
+		Min:          &min,
+		StateTopic:   "porch/state",
+		Interval:     "5m",
+		Internal:     "x",
+		Device:       &testDevice{Model: "P1"},
+		unexported:   "x",
+	}
+
+	got := New().SetFields(spec, "icon").BuildMap()
+	want := map[string]interface{}{
+		"name":          "Porch",
+		"command_topic": "porch/set",
+		"retain":        false,
+		"min":           0.5,
+		"precision":     0,
+		"stat_t":        "porch/state",
+		"device":        map[string]interface{}{"model": "P1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SetFields() = %v, want %v", got, want)
+	}
+
+	// Omitted keys are left out even when set
+	spec.Icon = "mdi:door"
+	spec.Step = 2
+	got = New().SetFields(spec, "icon").BuildMap()
+	if _, ok := got["icon"]; ok {
+		t.Error("omitted key icon is in the payload")
+	}
+	if got["step"] != 2 {
+		t.Errorf("step = %v, want 2", got["step"])
+	}
+
+	// Non-structs are ignored
+	if got := New().SetFields("x").BuildMap(); len(got) != 0 {
+		t.Errorf("SetFields(string) = %v, want empty", got)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	want := []string{"command_topic", "device", "min", "name", "options", "precision", "retain", "stat_t", "step"}
+	if got := Keys(&testSpec{}, "icon"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if got := Keys("x"); got != nil {
+		t.Errorf("Keys(string) = %v, want nil", got)
+	}
+}
+
+func TestFieldKey(t *testing.T) {
+	typ := reflect.TypeOf(testSpec{})
+	tests := []struct {
+		field    string
+		key      string
+		omitZero bool
+	}{
+		{"CommandTopic", "command_topic", false},
+		{"Step", "step", true},
+		{"StateTopic", "stat_t", false},
+		{"Interval", "", false},
+		{"Internal", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			field, _ := typ.FieldByName(tt.field)
+			key, omitZero := FieldKey(field)
+			if key != tt.key || omitZero != tt.omitZero {
+				t.Errorf("FieldKey() = %q, %v, want %q, %v", key, omitZero, tt.key, tt.omitZero)
+			}
+		})
+	}
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package controller
+package registry
 
 import (
 	"encoding/json"
@@ -68,13 +68,11 @@ func TestMQTTCameraPayload_MatchesLegacyFormat(t *testing.T) {
 		},
 	}
 
-	wrapper := &mqttCameraWrapper{camera}
 	spec := camera.Spec
 
-	r := &MQTTCameraReconciler{}
-	pb, err := r.buildPayload(wrapper, "2-2678647_2-2678647_forecast_chart")
+	pb, err := Lookup("MQTTCamera").Build(camera)
 	if err != nil {
-		t.Fatalf("buildPayload failed: %v", err)
+		t.Fatalf("Build failed: %v", err)
 	}
 
 	// Add unique_id (normally done by base reconciler)
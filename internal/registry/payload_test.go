@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/payload"
+)
+
+// TestPayloadConformance checks that every spec field reaches the discovery
+// payload of its kind, unless it is tagged hass:"-" or omitted for the kind,
+// and that Home Assistant accepts its key and type for the component.
+func TestPayloadConformance(t *testing.T) {
+	for _, k := range Entities() {
+		if k.IsGeneric() {
+			// Its payload is checked against the schema of its spec
+			// component when it is reconciled.
+			continue
+		}
+		t.Run(k.Kind, func(t *testing.T) {
+			schema := hass.Lookup(k.Component)
+			if schema == nil {
+				t.Fatalf("no discovery schema for component %q", k.Component)
+			}
+
+			spec, ok := reflect.TypeOf(k.New()).Elem().FieldByName("Spec")
+			if !ok {
+				t.Fatalf("%s has no Spec field", k.Kind)
+			}
+			specType := spec.Type
+			keys := make(map[string]bool)
+
+			for _, index := range leafFields(specType, nil) {
+				field := specType.FieldByIndex(index)
+				key, _ := payload.FieldKey(field)
+				if key == "" {
+					continue
+				}
+				keys[key] = true
+
+				obj := k.New()
+				spec := reflect.ValueOf(obj).Elem().FieldByName("Spec")
+				setNonZero(t, spec.FieldByIndex(index))
+
+				pb, err := k.Build(obj.(Entity))
+				if err != nil {
+					t.Fatalf("Build() error: %v", err)
+				}
+				value, ok := pb.BuildMap()[key]
+				switch omitted := slices.Contains(k.Omit, key); {
+				case omitted && ok:
+					t.Errorf("%s reaches the payload as %q, but the kind omits it", field.Name, key)
+				case !omitted && !ok:
+					t.Errorf("%s does not reach the payload as %q", field.Name, key)
+				case ok && !schema.Accepts(key):
+					t.Errorf("%s reaches the payload as %q, which %s does not accept", field.Name, key, k.Component)
+				case ok && !schema.Keys[key].Matches(value):
+					t.Errorf("%s reaches the payload as %q, which must be of type %s", field.Name, key, schema.Keys[key])
+				}
+			}
+
+			for _, key := range k.Omit {
+				if !keys[key] {
+					t.Errorf("omitted key %q is not a field of the spec", key)
+				}
+			}
+		})
+	}
+}
+
+// leafFields returns the index paths of the fields of t, flattening embedded structs.
+func leafFields(t reflect.Type, prefix []int) [][]int {
+	var fields [][]int
+	for i := 0; i < t.NumField(); i++ {
+		index := append(slices.Clone(prefix), i)
+		if f := t.Field(i); f.Anonymous && f.Type.Kind() == reflect.Struct {
+			fields = append(fields, leafFields(f.Type, index)...)
+			continue
+		}
+		fields = append(fields, index)
+	}
+	return fields
+}
+
+// setNonZero sets v to a value that is never left out of a payload.
+func setNonZero(t *testing.T, v reflect.Value) {
+	t.Helper()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		setNonZero(t, v.Elem())
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		setNonZero(t, v.Index(0))
+	case reflect.Struct:
+		for _, index := range leafFields(v.Type(), nil) {
+			setNonZero(t, v.FieldByIndex(index))
+		}
+	default:
+		t.Fatalf("cannot set a %s", v.Type())
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registry describes every resource kind the operator handles: its
+// Go type, Home Assistant component, plural, UI category and payload builder.
+// Controllers, garbage collection, metrics and the API are driven from it.
+package registry
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/payload"
+)
+
+// Entity is implemented by all resource types published as discovery messages.
+type Entity interface {
+	client.Object
+	GetCommonSpec() *mqttv1alpha1.CommonSpec
+	GetCommonStatus() *mqttv1alpha1.CommonStatus
+}
+
+// Generic is implemented by MQTTEntity, whose component and discovery
+// options are set in its spec rather than by its kind.
+type Generic interface {
+	Entity
+	GetComponent() string
+	GetConfig() *runtime.RawExtension
+}
+
+// PayloadBuilder builds the kind-specific part of the discovery payload for an
+// entity. Fields shared by all kinds are added by the caller.
+type PayloadBuilder func(obj Entity) (*payload.Builder, error)
+
+// Kind describes a resource kind.
+type Kind struct {
+	// Kind is the Kubernetes kind, e.g. MQTTButton.
+	Kind string
+	// Component is the Home Assistant component, e.g. button. It is empty
+	// for kinds that are not published and for MQTTEntity, whose component
+	// is set per object; use ComponentOf for those.
+	Component string
+	// Plural is the resource name, e.g. mqttbuttons.
+	Plural string
+	// Description and Category are shown in the web UI.
+	Description string
+	Category    string
+	// Omit lists the payload keys the component does not accept although
+	// the spec has a field for them.
+	Omit []string
+	// Keys are the payload keys the typed fields of the spec map to, sorted.
+	Keys []string
+	// New returns an empty object of the kind.
+	New func() client.Object
+	// Build builds the discovery payload. It is nil for kinds that are not
+	// published, such as MQTTDevice.
+	Build PayloadBuilder
+}
+
+// IsEntity reports whether the kind is published as a discovery message. It
+// is false for a nil Kind, so the result of Lookup can be tested directly.
+func (k *Kind) IsEntity() bool {
+	return k != nil && k.Build != nil
+}
+
+// IsGeneric reports whether the component of the kind is set per object.
+func (k *Kind) IsGeneric() bool {
+	return k.IsEntity() && k.Component == ""
+}
+
+// ComponentOf returns the Home Assistant component obj is published as.
+func (k *Kind) ComponentOf(obj Entity) string {
+	if g, ok := obj.(Generic); ok {
+		return g.GetComponent()
+	}
+	return k.Component
+}
+
+// GroupVersionKind returns the GroupVersionKind of the kind.
+func (k *Kind) GroupVersionKind() schema.GroupVersionKind {
+	return mqttv1alpha1.GroupVersion.WithKind(k.Kind)
+}
+
+// NewList returns an empty list of the kind.
+func (k *Kind) NewList() client.ObjectList {
+	obj, err := scheme.New(mqttv1alpha1.GroupVersion.WithKind(k.Kind + "List"))
+	if err != nil {
+		panic(fmt.Sprintf("registry: %s has no list type: %v", k.Kind, err))
+	}
+	return obj.(client.ObjectList)
+}
+
+// entity describes a published kind. Its payload is built from the fields of
+// its spec with payload.Builder.SetFields, leaving out the keys in omit.
+func entity[T any, P interface {
+	*T
+	Entity
+}](component, plural, description, category string, omit ...string) *Kind {
+	kind := reflect.TypeOf((*T)(nil)).Elem().Name()
+	spec, _ := reflect.TypeOf((*T)(nil)).Elem().FieldByName("Spec")
+	return &Kind{
+		Kind:        kind,
+		Component:   component,
+		Plural:      plural,
+		Description: description,
+		Category:    category,
+		Omit:        omit,
+		Keys:        payload.Keys(reflect.New(spec.Type).Interface(), omit...),
+		New:         func() client.Object { return P(new(T)) },
+		Build: func(obj Entity) (*payload.Builder, error) {
+			o, ok := obj.(P)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object type %T for %s", obj, kind)
+			}
+			spec := reflect.ValueOf(o).Elem().FieldByName("Spec")
+			return payload.New().SetFields(spec.Addr().Interface(), omit...), nil
+		},
+	}
+}
+
+var (
+	// readOnly lists the keys of CommonSpec that components without a
+	// command topic do not accept.
+	readOnly = []string{"retain"}
+
+	// nonEntity lists the keys of CommonSpec that tags and device triggers,
+	// which do not create an entity, do not accept.
+	nonEntity = []string{
+		"name", "icon", "entity_category", "enabled_by_default", "object_id",
+		"availability_topic", "retain", "json_attributes_topic", "json_attributes_template",
+	}
+)
+
+// kinds lists all kinds in the order they are shown in the web UI.
+var kinds = []*Kind{
+	entity[mqttv1alpha1.MQTTButton]("button", "mqttbuttons", "Stateless button that publishes when pressed", "Controls"),
+	entity[mqttv1alpha1.MQTTSwitch]("switch", "mqttswitches", "On/off switch with state", "Controls"),
+	entity[mqttv1alpha1.MQTTScene]("scene", "mqttscenes", "Scene activation", "Controls"),
+	entity[mqttv1alpha1.MQTTSelect]("select", "mqttselects", "Dropdown selector from options", "Controls"),
+	entity[mqttv1alpha1.MQTTNumber]("number", "mqttnumbers", "Numeric input with min/max", "Controls"),
+	entity[mqttv1alpha1.MQTTText]("text", "mqtttexts", "Text input field", "Controls"),
+	entity[mqttv1alpha1.MQTTSensor]("sensor", "mqttsensors", "Read-only sensor value", "Sensors", readOnly...),
+	entity[mqttv1alpha1.MQTTBinarySensor]("binary_sensor", "mqttbinarysensors", "On/off sensor state", "Sensors", readOnly...),
+	entity[mqttv1alpha1.MQTTEvent]("event", "mqttevents", "Event trigger entity", "Sensors", readOnly...),
+	entity[mqttv1alpha1.MQTTLight]("light", "mqttlights", "Light with brightness/color", "Lighting"),
+	entity[mqttv1alpha1.MQTTClimate]("climate", "mqttclimates", "HVAC/thermostat control", "Climate"),
+	entity[mqttv1alpha1.MQTTHumidifier]("humidifier", "mqtthumidifiers", "Humidifier/dehumidifier", "Climate"),
+	entity[mqttv1alpha1.MQTTWaterHeater]("water_heater", "mqttwaterheaters", "Water heater control", "Climate"),
+	entity[mqttv1alpha1.MQTTFan]("fan", "mqttfans", "Fan with speed control", "Climate"),
+	entity[mqttv1alpha1.MQTTLock]("lock", "mqttlocks", "Lock/unlock control", "Security"),
+	entity[mqttv1alpha1.MQTTAlarmControlPanel]("alarm_control_panel", "mqttalarmcontrolpanels", "Alarm system control", "Security"),
+	entity[mqttv1alpha1.MQTTCover]("cover", "mqttcovers", "Blinds/garage doors", "Covers"),
+	entity[mqttv1alpha1.MQTTValve]("valve", "mqttvalves", "Water/gas valve control", "Covers"),
+	entity[mqttv1alpha1.MQTTVacuum]("vacuum", "mqttvacuums", "Robot vacuum control", "Devices"),
+	entity[mqttv1alpha1.MQTTLawnMower]("lawn_mower", "mqttlawnmowers", "Robot lawn mower", "Devices"),
+	entity[mqttv1alpha1.MQTTSiren]("siren", "mqttsirens", "Siren/alarm device", "Devices"),
+	entity[mqttv1alpha1.MQTTCamera]("camera", "mqttcameras", "Camera image entity", "Media", readOnly...),
+	entity[mqttv1alpha1.MQTTImage]("image", "mqttimages", "Static image entity", "Media", readOnly...),
+	entity[mqttv1alpha1.MQTTNotify]("notify", "mqttnotifies", "Notification service", "Media"),
+	entity[mqttv1alpha1.MQTTUpdate]("update", "mqttupdates", "Firmware update entity", "Media"),
+	entity[mqttv1alpha1.MQTTDeviceTracker]("device_tracker", "mqttdevicetrackers", "Device location tracking", "Tracking", readOnly...),
+	entity[mqttv1alpha1.MQTTTag]("tag", "mqtttags", "NFC/RFID tag scanner", "Tracking", nonEntity...),
+	entity[mqttv1alpha1.MQTTDeviceTrigger]("device_automation", "mqttdevicetriggers", "Device automation trigger", "Tracking", nonEntity...),
+	entity[mqttv1alpha1.MQTTEntity]("", "mqttentities", "Entity of any MQTT platform with free-form config", "Utility"),
+	{
+		Kind:        "MQTTDevice",
+		Plural:      "mqttdevices",
+		Description: "Shared device configuration",
+		Category:    "Utility",
+		New:         func() client.Object { return &mqttv1alpha1.MQTTDevice{} },
+	},
+}
+
+var (
+	scheme = runtime.NewScheme()
+
+	byKind      = make(map[string]*Kind, len(kinds))
+	byComponent = make(map[string]*Kind, len(kinds))
+	entities    []*Kind
+)
+
+func init() {
+	utilruntime.Must(mqttv1alpha1.AddToScheme(scheme))
+
+	for _, k := range kinds {
+		byKind[k.Kind] = k
+		if k.IsEntity() {
+			if !k.IsGeneric() {
+				byComponent[k.Component] = k
+			}
+			entities = append(entities, k)
+		}
+	}
+}
+
+// All returns every kind, in the order they are shown in the web UI.
+func All() []*Kind {
+	return kinds
+}
+
+// Entities returns the kinds published as discovery messages.
+func Entities() []*Kind {
+	return entities
+}
+
+// Lookup returns the kind named kind, or nil.
+func Lookup(kind string) *Kind {
+	return byKind[kind]
+}
+
+// ForComponent returns the kind published as the Home Assistant component, or nil.
+func ForComponent(component string) *Kind {
+	return byComponent[component]
+}
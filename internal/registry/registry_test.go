@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"testing"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+)
+
+func TestKinds(t *testing.T) {
+	components := make(map[string]string)
+	for _, k := range All() {
+		t.Run(k.Kind, func(t *testing.T) {
+			if got := Lookup(k.Kind); got != k {
+				t.Errorf("Lookup(%q) = %v", k.Kind, got)
+			}
+
+			obj := k.New()
+			if _, err := scheme.New(k.GroupVersionKind()); err != nil {
+				t.Errorf("kind is not registered with the scheme: %v", err)
+			}
+			gvks, _, err := scheme.ObjectKinds(obj)
+			if err != nil || gvks[0].Kind != k.Kind {
+				t.Errorf("New() returned %T, want %s", obj, k.Kind)
+			}
+			if k.NewList() == nil {
+				t.Error("NewList() = nil")
+			}
+
+			if !k.IsEntity() {
+				if k.Component != "" {
+					t.Errorf("non-entity kind has component %q", k.Component)
+				}
+				return
+			}
+			if _, ok := obj.(Entity); !ok {
+				t.Fatalf("%T does not implement Entity", obj)
+			}
+			if _, err := k.Build(obj.(Entity)); err != nil {
+				t.Errorf("Build() error: %v", err)
+			}
+			if _, ok := obj.(Generic); ok != k.IsGeneric() {
+				t.Errorf("%T implements Generic = %v, want %v", obj, ok, k.IsGeneric())
+			}
+			if k.IsGeneric() {
+				return
+			}
+			if other, ok := components[k.Component]; ok {
+				t.Errorf("component %q is also used by %s", k.Component, other)
+			}
+			components[k.Component] = k.Kind
+			if ForComponent(k.Component) != k {
+				t.Errorf("ForComponent(%q) did not return %s", k.Component, k.Kind)
+			}
+		})
+	}
+
+	if Lookup("MQTTDevice").IsEntity() {
+		t.Error("MQTTDevice is an entity, want not published")
+	}
+	if Lookup("MQTTUnknown") != nil || Lookup("MQTTUnknown").IsEntity() {
+		t.Error("Lookup(MQTTUnknown) found a kind")
+	}
+}
+
+func TestComponentOf(t *testing.T) {
+	entity := &mqttv1alpha1.MQTTEntity{Spec: mqttv1alpha1.MQTTEntitySpec{Component: "siren"}}
+	if got := Lookup("MQTTEntity").ComponentOf(entity); got != "siren" {
+		t.Errorf("ComponentOf(MQTTEntity) = %q, want siren", got)
+	}
+	if got := Lookup("MQTTButton").ComponentOf(&mqttv1alpha1.MQTTButton{}); got != "button" {
+		t.Errorf("ComponentOf(MQTTButton) = %q, want button", got)
+	}
+	if ForComponent("") != nil {
+		t.Error("ForComponent(\"\") found a kind")
+	}
+}
+
+func TestBuild_WrongType(t *testing.T) {
+	k := Lookup("MQTTButton")
+	if _, err := k.Build(&mqttv1alpha1.MQTTSwitch{}); err == nil {
+		t.Error("Build(MQTTSwitch) succeeded, want error")
+	}
+}
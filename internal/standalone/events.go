@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// eventLogger is a record.EventRecorder that logs events, as there is no
+// API server to record them in standalone mode.
+type eventLogger struct {
+	log logr.Logger
+}
+
+// NewEventRecorder returns a record.EventRecorder that writes events to log.
+func NewEventRecorder(log logr.Logger) record.EventRecorder {
+	return &eventLogger{log: log.WithName("events")}
+}
+
+func (e *eventLogger) Event(object runtime.Object, eventtype, reason, message string) {
+	keysAndValues := []interface{}{"type", eventtype, "reason", reason}
+	if obj, ok := object.(client.Object); ok {
+		keysAndValues = append(keysAndValues, "object", obj.GetName())
+	}
+	if eventtype == corev1.EventTypeWarning {
+		e.log.Info("Warning: "+message, keysAndValues...)
+		return
+	}
+	e.log.Info(message, keysAndValues...)
+}
+
+func (e *eventLogger) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	e.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (e *eventLogger) AnnotatedEventf(object runtime.Object, _ map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	e.Eventf(object, eventtype, reason, messageFmt, args...)
+}
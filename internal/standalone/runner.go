@@ -0,0 +1,445 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package standalone publishes entities defined in manifest files without
+// Kubernetes, for hosts that run the controller with Docker Compose or similar.
+package standalone
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/controller"
+	"github.com/spontus/hass-crds/internal/hass"
+	"github.com/spontus/hass-crds/internal/instance"
+	"github.com/spontus/hass-crds/internal/manifest"
+	"github.com/spontus/hass-crds/internal/mqtt"
+	"github.com/spontus/hass-crds/internal/registry"
+)
+
+const (
+	// DefaultPollInterval is how often the manifest directory is checked for changes.
+	DefaultPollInterval = 5 * time.Second
+
+	// DefaultStateFileName is the state file created in the manifest
+	// directory. It is hidden so it is not read as a manifest.
+	DefaultStateFileName = ".hass-crds-state.json"
+)
+
+// Config holds configuration for the standalone mode.
+type Config struct {
+	// Dir is the directory the manifests are read from, recursively.
+	Dir string
+	// StateFile records what was published, in place of resource status.
+	StateFile string
+	// Namespace is given to resources that do not set one.
+	Namespace string
+	// PollInterval is how often Dir is checked for changes.
+	PollInterval time.Duration
+	// TargetVersion is the oldest Home Assistant release payloads are built for.
+	TargetVersion hass.Version
+}
+
+// NewConfigFromEnv creates a Config from environment variables. Standalone
+// mode is enabled when Dir is set.
+func NewConfigFromEnv() Config {
+	cfg := Config{
+		Dir:          os.Getenv("STANDALONE_DIR"),
+		StateFile:    os.Getenv("STANDALONE_STATE_FILE"),
+		Namespace:    os.Getenv("STANDALONE_NAMESPACE"),
+		PollInterval: DefaultPollInterval,
+	}
+	if cfg.StateFile == "" && cfg.Dir != "" {
+		cfg.StateFile = filepath.Join(cfg.Dir, DefaultStateFileName)
+	}
+	if cfg.Namespace == "" {
+		cfg.Namespace = "default"
+	}
+	if v := os.Getenv("STANDALONE_POLL_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			cfg.PollInterval = d
+		}
+	}
+	return cfg
+}
+
+// EntityState is what is known about a published entity. It takes the
+// place of the resource status and survives restarts in the state file.
+type EntityState struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Topic     string `json:"topic,omitempty"`
+	// Hash is the SHA-256 of the last published payload.
+	Hash          string     `json:"hash,omitempty"`
+	LastPublished *time.Time `json:"lastPublished,omitempty"`
+	// Error is the reason the entity could not be published, if any.
+	Error string `json:"error,omitempty"`
+}
+
+func (s *EntityState) key() string {
+	return s.Kind + "/" + s.Namespace + "/" + s.Name
+}
+
+// Runner keeps the entities published to match the manifests in a directory.
+// The manifests are loaded into an in-memory store that stands in for the
+// API server: deviceRef is resolved from it, and an OrphanCollector given
+// Client only keeps the topics of the file set.
+//
+// Entities are published through the same BaseReconciler logic as the
+// controllers. A file or resource that disappears is removed from Home
+// Assistant, including across restarts, as the state file remembers it.
+type Runner struct {
+	config   Config
+	base     *controller.BaseReconciler
+	store    client.Client
+	selector labels.Selector
+	log      logr.Logger
+
+	// fingerprint identifies the manifest files last loaded.
+	fingerprint string
+	// objects are the resources in the store, by kind, namespace and name.
+	objects map[string]client.Object
+	state   map[string]*EntityState
+	// published holds the entities published since start, so every entity is
+	// published once after a restart even if its payload did not change.
+	published map[string]bool
+}
+
+// NewRunner creates a Runner publishing through mqttClient as the instance
+// described by inst. Entities published by a previous run are read from the
+// state file.
+func NewRunner(mqttClient mqtt.Client, config Config, inst *instance.Config, log logr.Logger) (*Runner, error) {
+	store := fake.NewClientBuilder().
+		WithScheme(manifest.Scheme).
+		WithStatusSubresource(&mqttv1alpha1.MQTTGarbageCollection{}).
+		Build()
+
+	r := &Runner{
+		config:   config,
+		store:    store,
+		selector: inst.Selector,
+		log:      log.WithName("standalone"),
+		base: &controller.BaseReconciler{
+			Client:        store,
+			MQTTClient:    mqttClient,
+			Log:           log.WithName("standalone"),
+			InstanceID:    inst.ID,
+			ClusterName:   inst.ClusterName,
+			TargetVersion: config.TargetVersion,
+		},
+		objects:   make(map[string]client.Object),
+		state:     make(map[string]*EntityState),
+		published: make(map[string]bool),
+	}
+	if err := r.loadState(); err != nil {
+		return nil, fmt.Errorf("loading state from %s: %w", config.StateFile, err)
+	}
+	return r, nil
+}
+
+// Client returns the store holding the resources of the manifests.
+func (r *Runner) Client() client.Client {
+	return r.store
+}
+
+// State returns the state of the entities, sorted by kind, namespace and name.
+func (r *Runner) State() []EntityState {
+	states := make([]EntityState, 0, len(r.state))
+	for _, s := range r.state {
+		states = append(states, *s)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].key() < states[j].key() })
+	return states
+}
+
+// Start polls the manifest directory and syncs on changes until ctx is done.
+// The caller is expected to have run Sync once already.
+func (r *Runner) Start(ctx context.Context) error {
+	r.log.Info("Watching manifests", "dir", r.config.Dir, "interval", r.config.PollInterval, "state", r.config.StateFile)
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.Sync(ctx); err != nil {
+				r.log.Error(err, "Failed to sync manifests")
+			}
+		}
+	}
+}
+
+// Sync loads the manifests if they changed since the last sync, publishes
+// the new and changed entities and removes those that are gone. A directory
+// that does not load leaves everything as it was. Failed publishes are
+// retried on the next sync.
+func (r *Runner) Sync(ctx context.Context) error {
+	fingerprint, err := fingerprint(r.config.Dir)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", r.config.Dir, err)
+	}
+	if fingerprint == r.fingerprint {
+		return nil
+	}
+	// Record it before loading so a broken file is reported once, not on every poll
+	r.fingerprint = fingerprint
+
+	set, err := manifest.Load([]string{r.config.Dir}, nil, r.config.Namespace)
+	if err != nil {
+		return fmt.Errorf("loading manifests, keeping the published entities: %w", err)
+	}
+
+	desired := make(map[string]client.Object)
+	var entities []string
+	for _, obj := range set.Devices {
+		desired[objectKey(obj)] = obj
+	}
+	for _, obj := range set.Defaults {
+		desired[objectKey(obj)] = obj
+	}
+	for _, obj := range set.Entities {
+		if r.selector != nil && !r.selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		key := objectKey(obj)
+		desired[key] = obj
+		entities = append(entities, key)
+	}
+	if err := r.updateStore(ctx, desired); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, key := range entities {
+		if !r.publish(ctx, key, desired[key]) {
+			failed++
+		}
+	}
+
+	keys := make([]string, 0, len(r.state))
+	for key := range r.state {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, ok := desired[key]; !ok && !r.remove(ctx, r.state[key]) {
+			failed++
+		}
+	}
+
+	if err := r.saveState(); err != nil {
+		r.log.Error(err, "Failed to save state", "file", r.config.StateFile)
+	}
+	if failed > 0 {
+		r.fingerprint = ""
+		return fmt.Errorf("%d entities not synced, will retry", failed)
+	}
+	return nil
+}
+
+// updateStore replaces the resources in the store with desired.
+func (r *Runner) updateStore(ctx context.Context, desired map[string]client.Object) error {
+	for key, obj := range r.objects {
+		if err := r.store.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("removing %s from the store: %w", key, err)
+		}
+	}
+	r.objects = make(map[string]client.Object, len(desired))
+	for key, obj := range desired {
+		obj = obj.DeepCopyObject().(client.Object)
+		if err := r.store.Create(ctx, obj); err != nil {
+			return fmt.Errorf("adding %s to the store: %w", key, err)
+		}
+		r.objects[key] = obj
+	}
+	return nil
+}
+
+// publish publishes the entity if its payload changed, or if it has not been
+// published since start, and records the outcome. It returns false if the
+// publish failed and should be retried. Entities that cannot be built, e.g.
+// for a missing MQTTDevice, are recorded and not retried until the files change.
+func (r *Runner) publish(ctx context.Context, key string, obj client.Object) bool {
+	s := r.state[key]
+	if s == nil {
+		kind := strings.SplitN(key, "/", 2)[0]
+		s = &EntityState{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		r.state[key] = s
+	}
+
+	rendered, err := r.base.Render(ctx, obj)
+	if err != nil {
+		r.log.Error(err, "Failed to build discovery payload", "kind", s.Kind, "namespace", s.Namespace, "name", s.Name)
+		s.Error = err.Error()
+		return true
+	}
+	hash := sha256.Sum256(rendered.Payload)
+	digest := hex.EncodeToString(hash[:])
+	if r.published[key] && s.Hash == digest && s.Error == "" {
+		return true
+	}
+
+	// The last topic is kept in the status, so an MQTTEntity whose
+	// component changed is removed from its previous topic
+	obj = obj.DeepCopyObject().(client.Object)
+	if e, ok := obj.(registry.Entity); ok {
+		e.GetCommonStatus().DiscoveryTopic = s.Topic
+	}
+	if err := r.base.PublishEntity(ctx, obj); err != nil {
+		r.log.Error(err, "Failed to publish discovery message", "kind", s.Kind, "namespace", s.Namespace, "name", s.Name)
+		s.Error = err.Error()
+		return false
+	}
+
+	now := time.Now()
+	s.Topic = rendered.Topic
+	s.Hash = digest
+	s.LastPublished = &now
+	s.Error = ""
+	r.published[key] = true
+	return true
+}
+
+// remove removes an entity whose resource is gone from Home Assistant and
+// forgets it. It returns false if the removal failed and should be retried.
+func (r *Runner) remove(ctx context.Context, s *EntityState) bool {
+	obj, err := manifest.Scheme.New(mqttv1alpha1.GroupVersion.WithKind(s.Kind))
+	if err != nil {
+		r.log.Error(err, "Forgetting entity of unknown kind", "kind", s.Kind, "namespace", s.Namespace, "name", s.Name)
+		delete(r.state, s.key())
+		return true
+	}
+	entity := obj.(client.Object)
+	entity.SetNamespace(s.Namespace)
+	entity.SetName(s.Name)
+	if e, ok := entity.(registry.Entity); ok {
+		e.GetCommonStatus().DiscoveryTopic = s.Topic
+	}
+
+	if err := r.base.DeleteEntity(ctx, entity); err != nil {
+		r.log.Error(err, "Failed to remove entity", "kind", s.Kind, "namespace", s.Namespace, "name", s.Name)
+		s.Error = err.Error()
+		return false
+	}
+	delete(r.state, s.key())
+	delete(r.published, s.key())
+	return true
+}
+
+// loadState reads the state file written by a previous run.
+func (r *Runner) loadState() error {
+	if r.config.StateFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(r.config.StateFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var states []*EntityState
+	if err := json.Unmarshal(data, &states); err != nil {
+		return err
+	}
+	for _, s := range states {
+		r.state[s.key()] = s
+	}
+	return nil
+}
+
+// saveState writes the state file via a temporary file and rename.
+func (r *Runner) saveState() error {
+	if r.config.StateFile == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(r.State(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(r.config.StateFile), "."+filepath.Base(r.config.StateFile)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), r.config.StateFile)
+}
+
+// objectKey returns the kind, namespace and name of obj.
+func objectKey(obj client.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	return kind + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// fingerprint summarizes the names, sizes and modification times of the
+// manifest files in dir, so changes can be detected without reading them.
+func fingerprint(dir string) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || !manifest.IsManifestFile(p) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00%d\n", p, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
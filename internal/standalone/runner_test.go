@@ -0,0 +1,200 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	mqttv1alpha1 "github.com/spontus/hass-crds/api/v1alpha1"
+	"github.com/spontus/hass-crds/internal/instance"
+	"github.com/spontus/hass-crds/internal/mqtt"
+)
+
+const (
+	deviceManifest = `apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: MQTTDevice
+metadata:
+  name: garage
+spec:
+  name: Garage
+  identifiers: ["garage-1"]
+`
+	switchManifest = `apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: MQTTSwitch
+metadata:
+  name: door
+spec:
+  commandTopic: garage/door/set
+  deviceRef:
+    name: garage
+`
+	buttonManifest = `apiVersion: mqtt.home-assistant.io/v1alpha1
+kind: MQTTButton
+metadata:
+  name: reboot
+spec:
+  commandTopic: garage/reboot
+`
+)
+
+func newTestRunner(t *testing.T, dir string, mock *mqtt.MockClient) *Runner {
+	t.Helper()
+	config := Config{Dir: dir, StateFile: filepath.Join(dir, DefaultStateFileName), Namespace: "home", PollInterval: time.Second}
+	r, err := NewRunner(mock, config, &instance.Config{}, logr.Discard())
+	if err != nil {
+		t.Fatalf("NewRunner() error: %v", err)
+	}
+	return r
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Make sure the change is seen even on filesystems with coarse timestamps
+	later := time.Now().Add(time.Second)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func publishedTopics(mock *mqtt.MockClient) map[string]string {
+	topics := make(map[string]string)
+	for _, m := range mock.GetPublishedMessages() {
+		topics[m.Topic] = string(m.Payload)
+	}
+	return topics
+}
+
+func TestRunner_Sync(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "device.yaml"), deviceManifest)
+	writeFile(t, filepath.Join(dir, "entities.yaml"), switchManifest+"---\n"+buttonManifest)
+
+	mock := mqtt.NewMockClient()
+	_ = mock.Connect(ctx)
+	r := newTestRunner(t, dir, mock)
+
+	if err := r.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error: %v", err)
+	}
+	topics := publishedTopics(mock)
+	if len(topics) != 2 || topics["homeassistant/switch/home/door/config"] == "" || topics["homeassistant/button/home/reboot/config"] == "" {
+		t.Fatalf("published %v, want the switch and the button", topics)
+	}
+
+	// The store holds the file set for deviceRef and the orphan collector
+	var devices mqttv1alpha1.MQTTDeviceList
+	if err := r.Client().List(ctx, &devices); err != nil || len(devices.Items) != 1 {
+		t.Errorf("store has %d devices (%v), want 1", len(devices.Items), err)
+	}
+
+	// Nothing changed, nothing is published
+	mock.ClearMessages()
+	if err := r.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error: %v", err)
+	}
+	if got := len(mock.GetPublishedMessages()); got != 0 {
+		t.Errorf("published %d messages without changes, want 0", got)
+	}
+
+	// A changed device republishes the entity referencing it
+	writeFile(t, filepath.Join(dir, "device.yaml"), deviceManifest+"  manufacturer: DIY\n")
+	if err := r.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error: %v", err)
+	}
+	if topics := publishedTopics(mock); len(topics) != 1 || topics["homeassistant/switch/home/door/config"] == "" {
+		t.Errorf("published %v, want only the switch", topics)
+	}
+
+	// A removed resource is removed from Home Assistant
+	mock.ClearMessages()
+	writeFile(t, filepath.Join(dir, "entities.yaml"), switchManifest)
+	if err := r.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error: %v", err)
+	}
+	if payload, ok := publishedTopics(mock)["homeassistant/button/home/reboot/config"]; !ok || payload != "" {
+		t.Errorf("published %v, want the button cleared", publishedTopics(mock))
+	}
+	if states := r.State(); len(states) != 1 || states[0].Kind != "MQTTSwitch" || states[0].LastPublished == nil {
+		t.Errorf("State() = %+v, want the switch", states)
+	}
+}
+
+func TestRunner_BrokenManifest(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "button.yaml"), buttonManifest)
+
+	mock := mqtt.NewMockClient()
+	_ = mock.Connect(ctx)
+	r := newTestRunner(t, dir, mock)
+	if err := r.Sync(ctx); err != nil {
+		t.Fatalf("Sync() error: %v", err)
+	}
+
+	// A file that does not load leaves the entities published
+	mock.ClearMessages()
+	writeFile(t, filepath.Join(dir, "button.yaml"), buttonManifest+"  bogusField: 1\n")
+	if err := r.Sync(ctx); err == nil {
+		t.Fatal("Sync() succeeded, want error")
+	}
+	if got := len(mock.GetPublishedMessages()); got != 0 {
+		t.Errorf("published %d messages, want 0", got)
+	}
+	if len(r.State()) != 1 {
+		t.Errorf("State() = %+v, want the button kept", r.State())
+	}
+
+	// The error is reported once, not on every poll
+	if err := r.Sync(ctx); err != nil {
+		t.Errorf("second Sync() error: %v", err)
+	}
+}
+
+func TestRunner_RemovedWhileStopped(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "button.yaml"), buttonManifest)
+
+	mock := mqtt.NewMockClient()
+	_ = mock.Connect(ctx)
+	if err := newTestRunner(t, dir, mock).Sync(ctx); err != nil {
+		t.Fatalf("Sync() error: %v", err)
+	}
+
+	if err := os.Remove(filepath.Join(dir, "button.yaml")); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new runner, as after a restart, learns of the button from the state file
+	mock.ClearMessages()
+	if err := newTestRunner(t, dir, mock).Sync(ctx); err != nil {
+		t.Fatalf("Sync() error: %v", err)
+	}
+	if payload, ok := publishedTopics(mock)["homeassistant/button/home/reboot/config"]; !ok || payload != "" {
+		t.Errorf("published %v, want the button cleared", publishedTopics(mock))
+	}
+}
@@ -19,20 +19,31 @@ package topic
 import (
 	"fmt"
 	"strings"
+
+	"github.com/spontus/hass-crds/internal/registry"
 )
 
-// ComponentToKind is a reverse mapping from Home Assistant component types to Kubernetes kinds.
-// Generated automatically from ComponentMapping.
-var ComponentToKind map[string]string
+var (
+	// ComponentMapping maps Kubernetes kinds to Home Assistant component types.
+	// Generated from the entity kinds in the registry.
+	ComponentMapping = make(map[string]string)
+
+	// ComponentToKind is a reverse mapping from Home Assistant component types to Kubernetes kinds.
+	ComponentToKind = make(map[string]string)
+)
 
 func init() {
-	ComponentToKind = make(map[string]string, len(ComponentMapping))
-	for kind, component := range ComponentMapping {
-		ComponentToKind[component] = kind
+	for _, k := range registry.Entities() {
+		if k.IsGeneric() {
+			continue
+		}
+		ComponentMapping[k.Kind] = k.Component
+		ComponentToKind[k.Component] = k.Kind
 	}
 }
 
 // DiscoveryTopicInfo holds parsed information from a discovery topic.
+// Namespace is the node ID, which includes the cluster name if one is set.
 type DiscoveryTopicInfo struct {
 	Prefix    string
 	Component string
@@ -59,72 +70,64 @@ func ParseDiscoveryTopic(topic string) (*DiscoveryTopicInfo, error) {
 // DefaultDiscoveryPrefix is the default Home Assistant MQTT discovery prefix.
 const DefaultDiscoveryPrefix = "homeassistant"
 
-// ComponentMapping maps Kubernetes kinds to Home Assistant component types.
-var ComponentMapping = map[string]string{
-	"MQTTButton":            "button",
-	"MQTTSwitch":            "switch",
-	"MQTTSensor":            "sensor",
-	"MQTTBinarySensor":      "binary_sensor",
-	"MQTTNumber":            "number",
-	"MQTTSelect":            "select",
-	"MQTTText":              "text",
-	"MQTTScene":             "scene",
-	"MQTTTag":               "tag",
-	"MQTTLight":             "light",
-	"MQTTCover":             "cover",
-	"MQTTLock":              "lock",
-	"MQTTValve":             "valve",
-	"MQTTFan":               "fan",
-	"MQTTSiren":             "siren",
-	"MQTTCamera":            "camera",
-	"MQTTImage":             "image",
-	"MQTTNotify":            "notify",
-	"MQTTUpdate":            "update",
-	"MQTTClimate":           "climate",
-	"MQTTHumidifier":        "humidifier",
-	"MQTTWaterHeater":       "water_heater",
-	"MQTTVacuum":            "vacuum",
-	"MQTTLawnMower":         "lawn_mower",
-	"MQTTAlarmControlPanel": "alarm_control_panel",
-	"MQTTDeviceTracker":     "device_tracker",
-	"MQTTDeviceTrigger":     "device_automation",
-	"MQTTEvent":             "event",
-}
-
 // DiscoveryTopic generates the MQTT discovery topic for an entity.
 // Format: <prefix>/<component>/<node_id>/<object_id>/config
-func DiscoveryTopic(kind, namespace, name string) string {
-	return DiscoveryTopicWithPrefix(DefaultDiscoveryPrefix, kind, namespace, name)
+func DiscoveryTopic(cluster, kind, namespace, name string) string {
+	return DiscoveryTopicWithPrefix(DefaultDiscoveryPrefix, cluster, kind, namespace, name)
 }
 
 // DiscoveryTopicWithPrefix generates the discovery topic with a custom prefix.
-func DiscoveryTopicWithPrefix(prefix, kind, namespace, name string) string {
+func DiscoveryTopicWithPrefix(prefix, cluster, kind, namespace, name string) string {
 	component, ok := ComponentMapping[kind]
 	if !ok {
 		// Default to lowercase kind with "mqtt" prefix removed
 		component = strings.ToLower(strings.TrimPrefix(kind, "MQTT"))
 	}
 
-	// Node ID uses namespace to ensure uniqueness across namespaces
-	nodeID := namespace
+	return componentTopic(prefix, cluster, component, namespace, name)
+}
 
+// ComponentDiscoveryTopic generates the discovery topic for an entity
+// published as component, for kinds whose component is set per resource.
+func ComponentDiscoveryTopic(cluster, component, namespace, name string) string {
+	return componentTopic(DefaultDiscoveryPrefix, cluster, component, namespace, name)
+}
+
+func componentTopic(prefix, cluster, component, namespace, name string) string {
 	// Object ID uses the resource name
 	objectID := name
 
-	return fmt.Sprintf("%s/%s/%s/%s/config", prefix, component, nodeID, objectID)
+	return fmt.Sprintf("%s/%s/%s/%s/config", prefix, component, NodeID(cluster, namespace), objectID)
+}
+
+// ClusterSeparator joins the cluster name and the namespace in node IDs and
+// unique IDs. Neither a cluster name nor a namespace can contain it, as both
+// are DNS-1123 labels, so "office-home"/"automation" and "office"/"home-automation"
+// stay distinct.
+const ClusterSeparator = "_"
+
+// NodeID returns the discovery node_id for a namespace. It is the namespace,
+// prefixed with the cluster name when one is set so that namespaces with the
+// same name in different clusters do not collide.
+func NodeID(cluster, namespace string) string {
+	if cluster == "" {
+		return namespace
+	}
+	return cluster + ClusterSeparator + namespace
 }
 
 // UniqueID generates a unique identifier for Home Assistant entity registry.
-// Format: <namespace>-<name>
-func UniqueID(namespace, name string) string {
-	return fmt.Sprintf("%s-%s", namespace, name)
+// Format: <namespace>-<name>, or <cluster>_<namespace>-<name> with a cluster
+// name. Without a cluster name the ID is unchanged from earlier releases.
+func UniqueID(cluster, namespace, name string) string {
+	return fmt.Sprintf("%s-%s", NodeID(cluster, namespace), name)
 }
 
 // UniqueIDWithOverride returns the provided uniqueID if non-empty,
-// otherwise generates one from namespace and name.
-func UniqueIDWithOverride(uniqueID, namespace, name string) string {
+// otherwise generates one from cluster, namespace and name.
+func UniqueIDWithOverride(uniqueID, cluster, namespace, name string) string {
 	if uniqueID != "" {
 		return uniqueID
 	}
-	return UniqueID(namespace, name)
+	return UniqueID(cluster, namespace, name)
 }
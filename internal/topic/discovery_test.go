@@ -140,7 +140,7 @@ func TestDiscoveryTopicRoundTrip(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.kind, func(t *testing.T) {
-			topic := DiscoveryTopic(tt.kind, tt.namespace, tt.name)
+			topic := DiscoveryTopic("", tt.kind, tt.namespace, tt.name)
 			info, err := ParseDiscoveryTopic(topic)
 			if err != nil {
 				t.Fatalf("ParseDiscoveryTopic(%q) failed: %v", topic, err)
@@ -158,3 +158,56 @@ func TestDiscoveryTopicRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterName(t *testing.T) {
+	tests := []struct {
+		name      string
+		cluster   string
+		override  string
+		wantTopic string
+		wantID    string
+	}{
+		{
+			name:      "single cluster keeps existing IDs",
+			wantTopic: "homeassistant/sensor/home-automation/temp/config",
+			wantID:    "home-automation-temp",
+		},
+		{
+			name:      "cluster name",
+			cluster:   "office",
+			wantTopic: "homeassistant/sensor/office_home-automation/temp/config",
+			wantID:    "office_home-automation-temp",
+		},
+		{
+			name:      "override wins",
+			cluster:   "office",
+			override:  "pinned",
+			wantTopic: "homeassistant/sensor/office_home-automation/temp/config",
+			wantID:    "pinned",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiscoveryTopic(tt.cluster, "MQTTSensor", "home-automation", "temp"); got != tt.wantTopic {
+				t.Errorf("DiscoveryTopic() = %q, want %q", got, tt.wantTopic)
+			}
+			if got := UniqueIDWithOverride(tt.override, tt.cluster, "home-automation", "temp"); got != tt.wantID {
+				t.Errorf("UniqueIDWithOverride() = %q, want %q", got, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestClusterNameNoCollision(t *testing.T) {
+	// A hyphen could belong to either the cluster name or the namespace
+	a := struct{ cluster, namespace string }{"office-home", "automation"}
+	b := struct{ cluster, namespace string }{"office", "home-automation"}
+
+	if ta, tb := DiscoveryTopic(a.cluster, "MQTTSensor", a.namespace, "temp"), DiscoveryTopic(b.cluster, "MQTTSensor", b.namespace, "temp"); ta == tb {
+		t.Errorf("DiscoveryTopic() = %q for both %v and %v", ta, a, b)
+	}
+	if ia, ib := UniqueID(a.cluster, a.namespace, "temp"), UniqueID(b.cluster, b.namespace, "temp"); ia == ib {
+		t.Errorf("UniqueID() = %q for both %v and %v", ia, a, b)
+	}
+}
@@ -192,6 +192,19 @@ function FieldRenderer({ name, schema, value, onChange, required }: FieldRendere
     )
   }
 
+  if (schema.type === 'object') {
+    return (
+      <JsonField
+        name={name}
+        label={label}
+        description={description}
+        value={value as Record<string, unknown> | undefined}
+        onChange={onChange}
+        required={required}
+      />
+    )
+  }
+
   if (schema.type === 'array') {
     return (
       <ArrayField
@@ -311,6 +324,65 @@ function FieldRenderer({ name, schema, value, onChange, required }: FieldRendere
   )
 }
 
+interface JsonFieldProps {
+  name: string
+  label: string
+  description?: string
+  value: Record<string, unknown> | undefined
+  onChange: (value: unknown) => void
+  required?: boolean
+}
+
+// JsonField edits free-form objects, such as config and extraConfig, as JSON.
+function JsonField({ name, label, description, value, onChange, required }: JsonFieldProps) {
+  const [text, setText] = useState(value ? JSON.stringify(value, null, 2) : '')
+  const [error, setError] = useState<string | null>(null)
+
+  const handleChange = (next: string) => {
+    setText(next)
+    if (next.trim() === '') {
+      setError(null)
+      onChange(undefined)
+      return
+    }
+    try {
+      const parsed = JSON.parse(next)
+      if (typeof parsed !== 'object' || parsed === null || Array.isArray(parsed)) {
+        setError('Must be a JSON object')
+        return
+      }
+      setError(null)
+      onChange(parsed)
+    } catch {
+      setError('Invalid JSON')
+    }
+  }
+
+  return (
+    <div>
+      <label htmlFor={name} className="label block mb-2">
+        {label}
+        {required && <span className="text-ha-red ml-1">*</span>}
+        {description && (
+          <span className="ml-2 text-slate-500 font-normal" title={description}>
+            <HelpCircle className="inline w-3 h-3" />
+          </span>
+        )}
+      </label>
+      <textarea
+        id={name}
+        value={text}
+        onChange={(e) => handleChange(e.target.value)}
+        placeholder="{}"
+        rows={6}
+        className="input font-mono text-sm"
+        required={required}
+      />
+      {error && <p className="text-xs text-ha-red mt-1">{error}</p>}
+    </div>
+  )
+}
+
 interface ObjectFieldProps {
   name: string
   schema: SchemaProperty
@@ -134,6 +134,7 @@ export default function EntityListPage() {
                       <td className="p-4">
                         <span className="text-xs px-2 py-1 rounded bg-slate-800 text-slate-300">
                           {entity.kind.replace('MQTT', '')}
+                          {entity.component && `: ${entity.component}`}
                         </span>
                       </td>
                     )}
@@ -8,6 +8,7 @@ export interface EntityType {
 export interface EntitySummary {
   kind: string
   apiVersion: string
+  component?: string
   name: string
   namespace: string
   displayName?: string
@@ -50,6 +50,10 @@ var _ = BeforeSuite(func() {
 	err = utils.BuildAndLoadControllerImage()
 	Expect(err).NotTo(HaveOccurred(), "Failed to build/load controller image")
 
+	By("Installing cert-manager")
+	err = utils.InstallCertManager()
+	Expect(err).NotTo(HaveOccurred(), "Failed to install cert-manager")
+
 	By("Installing CRDs")
 	err = utils.InstallCRDs()
 	Expect(err).NotTo(HaveOccurred(), "Failed to install CRDs")